@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/S4eedb/arvancloud-go/sdk"
+)
+
+func cacheCommand() *command {
+	return &command{
+		name:    "cache",
+		summary: "Manage the CDN cache of a domain",
+		commands: []*command{
+			{
+				name:    "purge",
+				args:    "<domain> [url...]",
+				summary: "Purge the given URLs from the cache of a domain, or all of it when none are given",
+				setup: func(fs *flag.FlagSet) func(c *cli, args []string) error {
+					return func(c *cli, args []string) error {
+						if len(args) == 0 {
+							return errUsage
+						}
+
+						client, err := c.Client()
+						if err != nil {
+							return err
+						}
+
+						if err := client.PurgeCache(c.ctx, args[0], sdk.CachePurgeOptions{URLs: args[1:]}); err != nil {
+							return err
+						}
+
+						if len(args) == 1 {
+							fmt.Fprintf(c.stderr, "Purged the cache of %s\n", args[0])
+						} else {
+							fmt.Fprintf(c.stderr, "Purged %d URLs from the cache of %s\n", len(args)-1, args[0])
+						}
+
+						return nil
+					}
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+func completionCommand(root *command) *command {
+	return &command{
+		name:    "completion",
+		args:    "<bash|zsh|fish>",
+		summary: "Write a shell completion script to standard output, e.g.\n  source <(arvan completion bash)",
+		setup: func(fs *flag.FlagSet) func(c *cli, args []string) error {
+			return func(c *cli, args []string) error {
+				if err := expectArgs(args, 1); err != nil {
+					return err
+				}
+
+				switch args[0] {
+				case "bash":
+					writeBashCompletion(c.stdout, root)
+				case "zsh":
+					fmt.Fprintln(c.stdout, "autoload -U +X bashcompinit && bashcompinit")
+					writeBashCompletion(c.stdout, root)
+				case "fish":
+					writeFishCompletion(c.stdout, root)
+				default:
+					return fmt.Errorf("unsupported shell %q, expected bash, zsh or fish", args[0])
+				}
+
+				return nil
+			}
+		},
+	}
+}
+
+// completionWords returns the words completing each command path, subcommands of
+// groups and "--flag"s of leaves, keyed by the space separated path
+func completionWords(root *command) map[string][]string {
+	words := map[string][]string{}
+
+	var walk func(cmd *command, path string)
+	walk = func(cmd *command, path string) {
+		if cmd.setup != nil {
+			fs := flag.NewFlagSet(path, flag.ContinueOnError)
+			(&cli{}).globalFlags(fs)
+			cmd.setup(fs)
+			fs.VisitAll(func(f *flag.Flag) {
+				if len(f.Name) > 1 {
+					words[path] = append(words[path], "--"+f.Name)
+				}
+			})
+		}
+
+		for _, sub := range cmd.commands {
+			words[path] = append(words[path], sub.name)
+			walk(sub, strings.TrimSpace(path+" "+sub.name))
+		}
+
+		sort.Strings(words[path])
+	}
+	walk(root, "")
+
+	return words
+}
+
+func writeBashCompletion(w io.Writer, root *command) {
+	words := completionWords(root)
+
+	paths := make([]string, 0, len(words))
+	for path := range words {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	fmt.Fprint(w, `_arvan() {
+    local cur="${COMP_WORDS[COMP_CWORD]}" path="" i
+    for ((i = 1; i < COMP_CWORD; i++)); do
+        case "${COMP_WORDS[i]}" in
+            -*) ;;
+            *) path="${path:+$path }${COMP_WORDS[i]}" ;;
+        esac
+    done
+
+    local words=""
+    case "$path" in
+`)
+	for _, path := range paths {
+		fmt.Fprintf(w, "        %q) words=%q ;;\n", path, strings.Join(words[path], " "))
+	}
+	fmt.Fprint(w, `    esac
+
+    COMPREPLY=($(compgen -W "$words" -- "$cur"))
+}
+complete -F _arvan arvan
+`)
+}
+
+func writeFishCompletion(w io.Writer, root *command) {
+	fmt.Fprintln(w, "complete -c arvan -f")
+
+	for _, group := range root.commands {
+		fmt.Fprintf(w, "complete -c arvan -n __fish_use_subcommand -a %s -d %q\n", group.name, firstLine(group.summary))
+
+		for _, sub := range group.commands {
+			fmt.Fprintf(w, "complete -c arvan -n '__fish_seen_subcommand_from %s; and not __fish_seen_subcommand_from %s' -a %s -d %q\n",
+				group.name, subcommandNames(group), sub.name, firstLine(sub.summary))
+		}
+	}
+
+	words := completionWords(root)
+	for path, ws := range words {
+		if !strings.Contains(path, " ") && path != "completion" {
+			continue
+		}
+
+		condition := "__fish_seen_subcommand_from " + path
+		if i := strings.Index(path, " "); i >= 0 {
+			condition = fmt.Sprintf("__fish_seen_subcommand_from %s; and __fish_seen_subcommand_from %s", path[:i], path[i+1:])
+		}
+
+		for _, word := range ws {
+			if strings.HasPrefix(word, "--") {
+				fmt.Fprintf(w, "complete -c arvan -n '%s' -l %s\n", condition, strings.TrimPrefix(word, "--"))
+			}
+		}
+	}
+}
+
+func subcommandNames(cmd *command) string {
+	names := make([]string, 0, len(cmd.commands))
+	for _, sub := range cmd.commands {
+		names = append(names, sub.name)
+	}
+
+	return strings.Join(names, " ")
+}
+
+func firstLine(s string) string {
+	return strings.SplitN(s, "\n", 2)[0]
+}
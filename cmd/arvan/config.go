@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/S4eedb/arvancloud-go/sdk"
+)
+
+// Client returns the sdk.Client of the invocation, creating it on first use from
+// the selected profile, or as sdk.NewClientFromEnv does when none is selected
+func (c *cli) Client() (*sdk.Client, error) {
+	if c.client != nil {
+		return c.client, nil
+	}
+
+	var (
+		client sdk.Client
+		err    error
+	)
+
+	if c.profile != "" {
+		client, err = sdk.NewClientFromProfile(c.profile)
+	} else {
+		client, err = sdk.NewClientFromEnv()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	c.client = &client
+
+	return c.client, nil
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/S4eedb/arvancloud-go/sdk"
+	"github.com/S4eedb/arvancloud-go/sdk/zonefile"
+)
+
+// stringsFlag collects the values of a flag given more than once
+type stringsFlag []string
+
+func (f *stringsFlag) String() string { return strings.Join(*f, ",") }
+
+func (f *stringsFlag) Set(v string) error {
+	*f = append(*f, v)
+	return nil
+}
+
+func dnsCommand() *command {
+	return &command{
+		name:    "dns",
+		summary: "Manage the DNS records of a domain",
+		commands: []*command{
+			{
+				name:    "list",
+				args:    "<domain>",
+				summary: "List the DNS records of a domain",
+				setup: func(fs *flag.FlagSet) func(c *cli, args []string) error {
+					return func(c *cli, args []string) error {
+						if err := expectArgs(args, 1); err != nil {
+							return err
+						}
+
+						client, err := c.Client()
+						if err != nil {
+							return err
+						}
+
+						records, err := client.ListDomainRecords(c.ctx, args[0], nil)
+						if err != nil {
+							return err
+						}
+
+						return c.print(records, func() table { return recordsTable(records...) })
+					}
+				},
+			},
+			{
+				name:    "add",
+				args:    "<domain>",
+				summary: "Add a DNS record to a domain. Values are zone file RDATA, e.g. \"10 mx.example.com.\";\nhost names not ending with a dot are relative to the domain.",
+				setup: func(fs *flag.FlagSet) func(c *cli, args []string) error {
+					recordType := fs.String("type", "", "record type, e.g. a, cname or txt")
+					name := fs.String("name", "@", "record name relative to the domain")
+					ttl := fs.Int("ttl", 0, "TTL in seconds, the API default when zero")
+					cloud := fs.Bool("cloud", false, "proxy the record through the CDN")
+					values := stringsFlag{}
+					fs.Var(&values, "value", "record value, repeat for A and AAAA records with several addresses")
+
+					return func(c *cli, args []string) error {
+						if err := expectArgs(args, 1); err != nil {
+							return err
+						}
+
+						if *recordType == "" || len(values) == 0 {
+							return fmt.Errorf("--type and --value are required")
+						}
+
+						record, err := parseRecord(args[0], *name, *recordType, values)
+						if err != nil {
+							return err
+						}
+						record.TTL = *ttl
+						record.Cloud = *cloud
+
+						client, err := c.Client()
+						if err != nil {
+							return err
+						}
+
+						created, err := client.CreateDomainRecord(c.ctx, args[0], record.GetCreateOptions())
+						if err != nil {
+							return err
+						}
+
+						return c.print(created, func() table { return recordsTable(*created) })
+					}
+				},
+			},
+			{
+				name:    "rm",
+				args:    "<domain> <record-id>",
+				summary: "Remove a DNS record from a domain",
+				setup: func(fs *flag.FlagSet) func(c *cli, args []string) error {
+					return func(c *cli, args []string) error {
+						if err := expectArgs(args, 2); err != nil {
+							return err
+						}
+
+						client, err := c.Client()
+						if err != nil {
+							return err
+						}
+
+						if err := client.DeleteDomainRecord(c.ctx, args[0], args[1]); err != nil {
+							return err
+						}
+
+						fmt.Fprintf(c.stderr, "Removed record %s of %s\n", args[1], args[0])
+						return nil
+					}
+				},
+			},
+			{
+				name:    "import",
+				args:    "<domain> <zone-file>",
+				summary: "Import the records of a zone file, \"-\" for standard input, into a domain",
+				setup: func(fs *flag.FlagSet) func(c *cli, args []string) error {
+					replace := fs.Bool("replace", false, "remove the records of the domain that are not in the zone file")
+					cloud := fs.Bool("cloud", false, "proxy the imported A, AAAA and CNAME records through the CDN")
+
+					return func(c *cli, args []string) error {
+						if err := expectArgs(args, 2); err != nil {
+							return err
+						}
+
+						zone := c.stdin
+						if args[1] != "-" {
+							f, err := os.Open(args[1])
+							if err != nil {
+								return err
+							}
+							defer f.Close()
+							zone = f
+						}
+
+						client, err := c.Client()
+						if err != nil {
+							return err
+						}
+
+						if err := client.ImportZone(c.ctx, args[0], zone, sdk.ImportOptions{ReplaceExisting: *replace, Cloud: *cloud}); err != nil {
+							return err
+						}
+
+						fmt.Fprintf(c.stderr, "Imported %s into %s\n", args[1], args[0])
+						return nil
+					}
+				},
+			},
+			{
+				name:    "export",
+				args:    "<domain>",
+				summary: "Write the records of a domain to standard output as a zone file",
+				setup: func(fs *flag.FlagSet) func(c *cli, args []string) error {
+					return func(c *cli, args []string) error {
+						if err := expectArgs(args, 1); err != nil {
+							return err
+						}
+
+						client, err := c.Client()
+						if err != nil {
+							return err
+						}
+
+						zone, err := client.ExportZone(c.ctx, args[0])
+						if err != nil {
+							return err
+						}
+
+						_, err = io.Copy(c.stdout, zone)
+						return err
+					}
+				},
+			},
+		},
+	}
+}
+
+// parseRecord builds a record from zone file RDATA values by parsing them as zone file lines
+func parseRecord(domain, name, recordType string, values []string) (sdk.DomainRecord, error) {
+	var zone strings.Builder
+	for _, value := range values {
+		fmt.Fprintf(&zone, "%s IN %s %s\n", name, strings.ToUpper(recordType), value)
+	}
+
+	records, err := zonefile.Parse(strings.NewReader(zone.String()), domain)
+	if err != nil {
+		return sdk.DomainRecord{}, err
+	}
+
+	if len(records) != 1 {
+		return sdk.DomainRecord{}, fmt.Errorf("only A and AAAA records take several values")
+	}
+
+	return records[0], nil
+}
+
+func recordsTable(records ...sdk.DomainRecord) table {
+	t := table{headers: []string{"ID", "TYPE", "NAME", "VALUE", "TTL", "CLOUD"}}
+
+	for _, r := range records {
+		t.rows = append(t.rows, []string{
+			r.ID,
+			strings.ToUpper(string(r.Type)),
+			r.Name,
+			recordValue(r),
+			strconv.Itoa(r.TTL),
+			strconv.FormatBool(r.Cloud),
+		})
+	}
+
+	return t
+}
+
+// recordValue formats the value of a record as zone file RDATA
+func recordValue(r sdk.DomainRecord) string {
+	lines, err := zonefile.FormatRecord(r)
+	if err != nil {
+		return fmt.Sprint(r.Value)
+	}
+
+	values := make([]string, 0, len(lines))
+	for _, line := range lines {
+		// name, TTL, class, type and RDATA are separated by tabs
+		fields := strings.SplitN(line, "\t", 5)
+		values = append(values, fields[len(fields)-1])
+	}
+
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/S4eedb/arvancloud-go/sdk"
+)
+
+func domainsCommand() *command {
+	return &command{
+		name:    "domains",
+		summary: "List, inspect, add and remove domains",
+		commands: []*command{
+			{
+				name:    "list",
+				summary: "List the domains of the account",
+				setup: func(fs *flag.FlagSet) func(c *cli, args []string) error {
+					return func(c *cli, args []string) error {
+						if err := expectArgs(args, 0); err != nil {
+							return err
+						}
+
+						client, err := c.Client()
+						if err != nil {
+							return err
+						}
+
+						domains, err := client.ListDomains(c.ctx, nil)
+						if err != nil {
+							return err
+						}
+
+						return c.print(domains, func() table { return domainsTable(domains...) })
+					}
+				},
+			},
+			{
+				name:    "get",
+				args:    "<domain>",
+				summary: "Show a domain",
+				setup: func(fs *flag.FlagSet) func(c *cli, args []string) error {
+					return func(c *cli, args []string) error {
+						if err := expectArgs(args, 1); err != nil {
+							return err
+						}
+
+						client, err := c.Client()
+						if err != nil {
+							return err
+						}
+
+						domain, err := client.GetDomain(c.ctx, args[0])
+						if err != nil {
+							return err
+						}
+
+						return c.print(domain, func() table { return domainsTable(*domain) })
+					}
+				},
+			},
+			{
+				name:    "create",
+				args:    "<domain>",
+				summary: "Add a domain to the account",
+				setup: func(fs *flag.FlagSet) func(c *cli, args []string) error {
+					partial := fs.Bool("partial", false, "keep the domain's name servers and point records at Arvancloud with CNAMEs")
+
+					return func(c *cli, args []string) error {
+						if err := expectArgs(args, 1); err != nil {
+							return err
+						}
+
+						client, err := c.Client()
+						if err != nil {
+							return err
+						}
+
+						createOpts := sdk.DomainCreateOptions{Domain: args[0], DomainType: sdk.DomainTypeFull}
+						if *partial {
+							createOpts.DomainType = sdk.DomainTypePartial
+						}
+
+						domain, err := client.CreateDomain(c.ctx, createOpts)
+						if err != nil {
+							return err
+						}
+
+						return c.print(domain, func() table { return domainsTable(*domain) })
+					}
+				},
+			},
+			{
+				name:    "delete",
+				args:    "<domain>",
+				summary: "Remove a domain and all of its settings from the account",
+				setup: func(fs *flag.FlagSet) func(c *cli, args []string) error {
+					return func(c *cli, args []string) error {
+						if err := expectArgs(args, 1); err != nil {
+							return err
+						}
+
+						client, err := c.Client()
+						if err != nil {
+							return err
+						}
+
+						if err := client.DeleteDomain(c.ctx, args[0]); err != nil {
+							return err
+						}
+
+						fmt.Fprintf(c.stderr, "Deleted domain %s\n", args[0])
+						return nil
+					}
+				},
+			},
+		},
+	}
+}
+
+func domainsTable(domains ...sdk.Domain) table {
+	t := table{headers: []string{"DOMAIN", "STATUS", "DNS", "NAME SERVERS", "CREATED"}}
+
+	for _, d := range domains {
+		t.rows = append(t.rows, []string{
+			d.Domain,
+			d.Status,
+			d.Services.DNS,
+			strings.Join(d.NsKeys, ","),
+			d.CreatedAt.Format("2006-01-02"),
+		})
+	}
+
+	return t
+}
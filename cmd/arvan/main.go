@@ -0,0 +1,224 @@
+// Command arvan manages Arvancloud CDN domains, DNS records, caching and
+// certificates from the command line.
+//
+//	arvan domains list
+//	arvan dns add example.com --type a --name www --value 192.0.2.1
+//	arvan -o yaml ssl status example.com
+//
+// The client is configured by the config file profile selected with --profile
+// or ARVANCLOUD_PROFILE, otherwise by ARVANCLOUD_TOKEN, otherwise by the
+// "default" profile; see sdk.Profile for the format of the config file,
+// ~/.config/arvancloud/config.toml unless ARVANCLOUD_CONFIG is set.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/S4eedb/arvancloud-go/sdk"
+)
+
+// command is a node of the command tree. Groups have commands, leaves have setup.
+type command struct {
+	name    string
+	args    string
+	summary string
+
+	// setup registers the flags of a leaf command and returns its run function
+	setup func(fs *flag.FlagSet) func(c *cli, args []string) error
+
+	commands []*command
+}
+
+func (cmd *command) find(name string) *command {
+	for _, sub := range cmd.commands {
+		if sub.name == name {
+			return sub
+		}
+	}
+
+	return nil
+}
+
+// cli holds the global options and state of an invocation
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	output  string
+	profile string
+
+	ctx    context.Context
+	client *sdk.Client
+}
+
+// errUsage is returned when a command is invoked with invalid arguments, after printing its usage
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	c := &cli{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr, ctx: context.Background()}
+
+	if err := c.run(os.Args[1:]); err != nil {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintf(c.stderr, "arvan: %s\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func rootCommand() *command {
+	root := &command{
+		name: "arvan",
+		commands: []*command{
+			domainsCommand(),
+			dnsCommand(),
+			cacheCommand(),
+			sslCommand(),
+		},
+	}
+	root.commands = append(root.commands, completionCommand(root))
+
+	return root
+}
+
+// globalFlags registers the flags accepted before and after any command
+func (c *cli) globalFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.output, "output", c.output, "output format: table, json or yaml")
+	fs.StringVar(&c.output, "o", c.output, "shorthand for --output")
+	fs.StringVar(&c.profile, "profile", c.profile, "config file profile to authenticate with")
+}
+
+func (c *cli) run(args []string) error {
+	c.output = outputTable
+	c.profile = os.Getenv(sdk.ProfileVar)
+
+	root := rootCommand()
+
+	fs := flag.NewFlagSet(root.name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() { c.usage(root, nil) }
+	c.globalFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return errUsage
+	}
+	args = fs.Args()
+
+	cmd, path := root, []string{}
+	for cmd.setup == nil {
+		if len(args) == 0 || args[0] == "help" {
+			c.usage(cmd, path)
+			if len(args) == 0 {
+				return errUsage
+			}
+			return nil
+		}
+
+		sub := cmd.find(args[0])
+		if sub == nil {
+			fmt.Fprintf(c.stderr, "unknown command %q\n\n", strings.Join(append(path, args[0]), " "))
+			c.usage(cmd, path)
+			return errUsage
+		}
+
+		cmd, path, args = sub, append(path, sub.name), args[1:]
+	}
+
+	fs = flag.NewFlagSet(strings.Join(path, " "), flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	c.globalFlags(fs)
+	runCommand := cmd.setup(fs)
+	fs.Usage = func() { c.usage(cmd, path); fs.PrintDefaults() }
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return errUsage
+	}
+
+	switch c.output {
+	case outputTable, outputJSON, outputYAML:
+	default:
+		return fmt.Errorf("unknown output format %q, expected table, json or yaml", c.output)
+	}
+
+	if err := runCommand(c, positional); err != nil {
+		if errors.Is(err, errUsage) {
+			fs.Usage()
+		}
+		return err
+	}
+
+	return nil
+}
+
+// parseInterspersed parses flags placed anywhere among the positional arguments
+// and returns the positional arguments. "--" ends flag parsing.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	positional := []string{}
+
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return positional, nil
+		}
+
+		// fs.Parse stops after consuming "--", everything after it is positional
+		if consumed := len(args) - len(rest); consumed > 0 && args[consumed-1] == "--" {
+			return append(positional, rest...), nil
+		}
+
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+func (c *cli) usage(cmd *command, path []string) {
+	name := strings.Join(append([]string{"arvan"}, path...), " ")
+
+	if cmd.setup != nil {
+		fmt.Fprintf(c.stderr, "Usage: %s [flags] %s\n\n%s\n\nFlags:\n", name, cmd.args, cmd.summary)
+		return
+	}
+
+	fmt.Fprintf(c.stderr, "Usage: %s <command> [flags]\n\nCommands:\n", name)
+
+	names := make([]string, 0, len(cmd.commands))
+	summaries := map[string]string{}
+	for _, sub := range cmd.commands {
+		names = append(names, sub.name)
+		summaries[sub.name] = firstLine(sub.summary)
+	}
+	sort.Strings(names)
+
+	for _, n := range names {
+		fmt.Fprintf(c.stderr, "  %-12s %s\n", n, summaries[n])
+	}
+
+	fmt.Fprintf(c.stderr, "\nGlobal flags:\n  -o, --output   table, json or yaml (default table)\n  --profile      config file profile to authenticate with\n")
+}
+
+// expectArgs returns errUsage unless exactly n positional arguments were given
+func expectArgs(args []string, n int) error {
+	if len(args) != n {
+		return errUsage
+	}
+
+	return nil
+}
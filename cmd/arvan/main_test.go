@@ -0,0 +1,240 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/S4eedb/arvancloud-go/sdk"
+	"github.com/S4eedb/arvancloud-go/sdk/arvantest"
+	"github.com/google/go-cmp/cmp"
+)
+
+// runCLI runs arvan and returns its standard output
+func runCLI(t *testing.T, args ...string) string {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	c := &cli{stdin: strings.NewReader(""), stdout: &stdout, stderr: &stderr, ctx: context.Background()}
+
+	if err := c.run(args); err != nil {
+		t.Fatalf("arvan %s: %s\n%s", strings.Join(args, " "), err, stderr.String())
+	}
+
+	return stdout.String()
+}
+
+func newTestServer(t *testing.T) *arvantest.Server {
+	t.Helper()
+
+	server := arvantest.NewServer()
+	t.Cleanup(server.Close)
+
+	t.Setenv(sdk.ConfigPathVar, filepath.Join(t.TempDir(), "config.toml"))
+	t.Setenv(sdk.ProfileVar, "")
+	t.Setenv(sdk.APIEnvVar, "Apikey test")
+	t.Setenv(sdk.APIHostVar, server.URL)
+
+	return server
+}
+
+// recordRequests starts a server forwarding to server that passes each request and
+// its body to record first, and returns its URL
+func recordRequests(t *testing.T, server *arvantest.Server, record func(r *http.Request, body []byte)) string {
+	t.Helper()
+
+	var mu sync.Mutex
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		mu.Lock()
+		record(r, body)
+		mu.Unlock()
+
+		server.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(proxy.Close)
+
+	return proxy.URL
+}
+
+func TestDomains(t *testing.T) {
+	server := newTestServer(t)
+	server.AddDomain("example.com")
+
+	runCLI(t, "domains", "create", "example.org")
+
+	out := runCLI(t, "domains", "list")
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "DOMAIN") || !strings.HasPrefix(lines[1], "example.com ") || !strings.HasPrefix(lines[2], "example.org ") {
+		t.Errorf("unexpected table:\n%s", out)
+	}
+
+	runCLI(t, "domains", "delete", "example.org")
+
+	out = runCLI(t, "domains", "list", "-o", "json")
+	if strings.Contains(out, "example.org") {
+		t.Errorf("expected example.org to be deleted:\n%s", out)
+	}
+}
+
+func TestDNS(t *testing.T) {
+	server := newTestServer(t)
+	server.AddDomain("example.com")
+
+	runCLI(t, "dns", "add", "example.com", "--type", "a", "--name", "www", "--value", "192.0.2.1", "--value", "192.0.2.2", "--ttl", "300")
+	runCLI(t, "dns", "add", "--type=mx", "--value", "10 mx.example.net.", "example.com")
+
+	out := runCLI(t, "dns", "list", "example.com")
+	for _, expected := range []string{"192.0.2.1, 192.0.2.2", "10 mx.example.net."} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected %q in table:\n%s", expected, out)
+		}
+	}
+
+	records := server.DomainRecords("example.com")
+	if len(records) != 2 || records[0].TTL != 300 || records[1].Name != "@" {
+		t.Fatalf("unexpected records %#v", records)
+	}
+
+	runCLI(t, "dns", "rm", "example.com", records[0].ID)
+	if records := server.DomainRecords("example.com"); len(records) != 1 {
+		t.Errorf("expected one record left, got %#v", records)
+	}
+}
+
+func TestDNSImportExport(t *testing.T) {
+	server := newTestServer(t)
+	server.AddDomain("example.com")
+
+	if _, err := server.AddDomainRecord("example.com", sdk.DomainRecord{
+		Name:  "legacy",
+		Type:  sdk.RecordTypeA,
+		TTL:   300,
+		Value: []sdk.AddressRecordValue{{IP: "192.0.2.9"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	zonePath := filepath.Join(t.TempDir(), "example.com.zone")
+	zone := "www 300 IN A 192.0.2.1\n@ 3600 IN MX 10 mx.example.net.\n"
+	if err := ioutil.WriteFile(zonePath, []byte(zone), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	runCLI(t, "dns", "import", "--replace", "--cloud", "example.com", zonePath)
+
+	records := server.DomainRecords("example.com")
+	if len(records) != 2 || records[0].Name != "www" || !records[0].Cloud || records[1].Type != sdk.RecordTypeMX {
+		t.Fatalf("unexpected records %#v", records)
+	}
+
+	out := runCLI(t, "dns", "export", "example.com")
+	for _, expected := range []string{"www\t300\tIN\tA\t192.0.2.1\n", "@\t3600\tIN\tMX\t10 mx.example.net.\n"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected %q in zone file:\n%s", expected, out)
+		}
+	}
+}
+
+func TestCachePurge(t *testing.T) {
+	server := newTestServer(t)
+	server.AddDomain("example.com")
+
+	var requests []string
+	t.Setenv(sdk.APIHostVar, recordRequests(t, server, func(r *http.Request, body []byte) {
+		requests = append(requests, fmt.Sprintf("%s %s?%s %s", r.Method, r.URL.Path, r.URL.RawQuery, body))
+	}))
+
+	runCLI(t, "cache", "purge", "example.com")
+	runCLI(t, "cache", "purge", "example.com", "https://example.com/a.css")
+
+	expected := []string{
+		"DELETE /cdn/4.0/domains/example.com/caching?purge=all {}",
+		`DELETE /cdn/4.0/domains/example.com/caching?purge=individual {"purge_urls":["https://example.com/a.css"]}`,
+	}
+	if !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+}
+
+func TestSSLStatus_yaml(t *testing.T) {
+	server := newTestServer(t)
+	server.AddDomain("example.com")
+
+	if err := server.SetSettings("example.com", "ssl", sdk.SSLSettings{
+		Type:         sdk.SSLTypeArvan,
+		Enabled:      true,
+		Certificates: []sdk.SSLCertificate{{ID: "c1", CommonName: "example.com", AltNames: []string{"*.example.com"}}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	out := runCLI(t, "--output", "yaml", "ssl", "status", "example.com")
+
+	for _, expected := range []string{
+		"ssl_type: arvan\nssl_status: true\n",
+		"certificates:\n  - id: c1\n    type: \"\"\n",
+		"    alt_names:\n      - \"*.example.com\"\n",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected %q in YAML:\n%s", expected, out)
+		}
+	}
+}
+
+func TestProfile(t *testing.T) {
+	server := newTestServer(t)
+	server.AddDomain("example.com")
+
+	var authorizations []string
+	proxyURL := recordRequests(t, server, func(r *http.Request, _ []byte) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+	})
+
+	// The profile points the client at the server and provides the API key,
+	// ARVANCLOUD_TOKEN is ignored
+	t.Setenv(sdk.APIHostVar, "")
+
+	config := filepath.Join(t.TempDir(), "config.toml")
+	if err := ioutil.WriteFile(config, []byte("[staging]\napi_key = \"Apikey staging\"\nbase_url = \""+proxyURL+"\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(sdk.ConfigPathVar, config)
+
+	if out := runCLI(t, "--profile", "staging", "domains", "list"); !strings.Contains(out, "example.com") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+
+	t.Setenv(sdk.ProfileVar, "staging")
+	if out := runCLI(t, "domains", "list"); !strings.Contains(out, "example.com") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+
+	expected := []string{"Apikey staging", "Apikey staging"}
+	if !cmp.Equal(authorizations, expected) {
+		t.Error(cmp.Diff(authorizations, expected))
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	newTestServer(t)
+
+	out := runCLI(t, "completion", "bash")
+	if !strings.Contains(out, `"dns add") words="--cloud --name --output --profile --ttl --type --value" ;;`) {
+		t.Errorf("unexpected bash completion:\n%s", out)
+	}
+
+	out = runCLI(t, "completion", "fish")
+	if !strings.Contains(out, "-a domains") {
+		t.Errorf("unexpected fish completion:\n%s", out)
+	}
+}
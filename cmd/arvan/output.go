@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// Output formats
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// table is the tabular form of a command's result
+type table struct {
+	headers []string
+	rows    [][]string
+}
+
+// print writes v in the selected output format. t is the table printed for the
+// table format, built lazily as JSON and YAML do not need it.
+func (c *cli) print(v interface{}, t func() table) error {
+	switch c.output {
+	case outputJSON:
+		e := json.NewEncoder(c.stdout)
+		e.SetIndent("", "  ")
+		return e.Encode(v)
+	case outputYAML:
+		return writeYAML(c.stdout, v)
+	}
+
+	tbl := t()
+	w := tabwriter.NewWriter(c.stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, strings.Join(tbl.headers, "\t"))
+	for _, row := range tbl.rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+
+	return w.Flush()
+}
+
+// writeYAML writes v as YAML, encoded through its JSON form so that json struct
+// tags apply and fields keep their order
+func writeYAML(w io.Writer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+
+	node, err := decodeNode(d)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	switch node.(type) {
+	case []yamlField, []interface{}:
+		writeYAMLNode(&buf, node, 0)
+	default:
+		buf.WriteString(yamlScalar(node) + "\n")
+	}
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// yamlField is a key of a JSON object, objects decode to []yamlField to keep their order
+type yamlField struct {
+	key   string
+	value interface{}
+}
+
+// decodeNode decodes the next JSON value into []yamlField, []interface{} or a scalar
+func decodeNode(d *json.Decoder) (interface{}, error) {
+	tok, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		fields := []yamlField{}
+		for d.More() {
+			key, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := decodeNode(d)
+			if err != nil {
+				return nil, err
+			}
+
+			fields = append(fields, yamlField{key: key.(string), value: value})
+		}
+		_, err := d.Token()
+		return fields, err
+	case json.Delim('['):
+		items := []interface{}{}
+		for d.More() {
+			item, err := decodeNode(d)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		_, err := d.Token()
+		return items, err
+	}
+
+	return tok, nil
+}
+
+// writeYAMLNode writes an object or array as a YAML block at indent
+func writeYAMLNode(buf *bytes.Buffer, node interface{}, indent int) {
+	pad := strings.Repeat(" ", indent)
+
+	switch n := node.(type) {
+	case []yamlField:
+		if len(n) == 0 {
+			buf.WriteString(pad + "{}\n")
+		}
+
+		for _, f := range n {
+			buf.WriteString(pad + yamlScalar(f.key) + ":")
+			writeYAMLValue(buf, f.value, indent)
+		}
+	case []interface{}:
+		if len(n) == 0 {
+			buf.WriteString(pad + "[]\n")
+		}
+
+		for _, item := range n {
+			// Write nested blocks compactly, "- key: value" rather than "-" and the
+			// block on the next line, by replacing the indentation of their first line.
+			// Scalars write no block.
+			var nested bytes.Buffer
+			writeYAMLNode(&nested, item, indent+2)
+			if nested.Len() > 0 {
+				buf.WriteString(pad + "- " + strings.TrimPrefix(nested.String(), pad+"  "))
+				continue
+			}
+
+			buf.WriteString(pad + "-")
+			writeYAMLValue(buf, item, indent)
+		}
+	}
+}
+
+// writeYAMLValue writes the value following a "key:" or "-" already written at indent
+func writeYAMLValue(buf *bytes.Buffer, value interface{}, indent int) {
+	switch v := value.(type) {
+	case []yamlField:
+		if len(v) == 0 {
+			buf.WriteString(" {}\n")
+			return
+		}
+		buf.WriteString("\n")
+		writeYAMLNode(buf, v, indent+2)
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString(" []\n")
+			return
+		}
+		buf.WriteString("\n")
+		writeYAMLNode(buf, v, indent+2)
+	default:
+		buf.WriteString(" " + yamlScalar(v) + "\n")
+	}
+}
+
+// yamlScalar formats a JSON scalar, quoting strings that YAML would read as another type
+func yamlScalar(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case json.Number:
+		return v.String()
+	case string:
+		if yamlNeedsQuotes(v) {
+			var buf bytes.Buffer
+			e := json.NewEncoder(&buf)
+			e.SetEscapeHTML(false)
+			_ = e.Encode(v)
+			return strings.TrimSuffix(buf.String(), "\n")
+		}
+		return v
+	}
+
+	return fmt.Sprint(v)
+}
+
+func yamlNeedsQuotes(s string) bool {
+	if s == "" || strings.TrimSpace(s) != s {
+		return true
+	}
+
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "y", "n", "null", "~":
+		return true
+	}
+
+	if strings.ContainsAny(s[:1], "-?:,[]{}#&*!|>'\"%@`0123456789.+") {
+		return true
+	}
+
+	return strings.Contains(s, ": ") || strings.Contains(s, " #") || strings.ContainsAny(s, "\n\t\r")
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"flag"
+	"strconv"
+	"strings"
+
+	"github.com/S4eedb/arvancloud-go/sdk"
+)
+
+func sslCommand() *command {
+	return &command{
+		name:    "ssl",
+		summary: "Inspect the HTTPS settings of a domain",
+		commands: []*command{
+			{
+				name:    "status",
+				args:    "<domain>",
+				summary: "Show the HTTPS settings and certificates of a domain",
+				setup: func(fs *flag.FlagSet) func(c *cli, args []string) error {
+					return func(c *cli, args []string) error {
+						if err := expectArgs(args, 1); err != nil {
+							return err
+						}
+
+						client, err := c.Client()
+						if err != nil {
+							return err
+						}
+
+						settings, err := client.GetSSLSettings(c.ctx, args[0])
+						if err != nil {
+							return err
+						}
+
+						return c.print(settings, func() table { return sslTable(settings) })
+					}
+				},
+			},
+		},
+	}
+}
+
+// sslTable lists the certificates of a domain, or its settings alone when it has none
+func sslTable(s *sdk.SSLSettings) table {
+	t := table{headers: []string{"SSL", "ENABLED", "HTTPS REDIRECT", "HSTS", "CERTIFICATE", "ACTIVE", "EXPIRES"}}
+
+	settings := []string{
+		string(s.Type),
+		strconv.FormatBool(s.Enabled),
+		strconv.FormatBool(s.HTTPSRedirect),
+		strconv.FormatBool(s.HSTS),
+	}
+
+	if len(s.Certificates) == 0 {
+		t.rows = append(t.rows, append(settings, "-", "-", "-"))
+	}
+
+	for _, cert := range s.Certificates {
+		names := append([]string{cert.CommonName}, cert.AltNames...)
+		t.rows = append(t.rows, append(append([]string{}, settings...),
+			strings.Join(names, ","),
+			strconv.FormatBool(cert.Active),
+			cert.ExpiredAt.Format("2006-01-02"),
+		))
+	}
+
+	return t
+}
@@ -0,0 +1,104 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// ImageOptimizationLevel is how aggressively images are recompressed
+type ImageOptimizationLevel string
+
+// ImageOptimizationLevel enums
+const (
+	ImageOptimizationLossless ImageOptimizationLevel = "lossless"
+	ImageOptimizationLossy    ImageOptimizationLevel = "lossy"
+)
+
+// AccelerationSettings represents the content acceleration settings of a Domain
+type AccelerationSettings struct {
+	MinifyJS   bool `json:"minify_js"`
+	MinifyCSS  bool `json:"minify_css"`
+	MinifyHTML bool `json:"minify_html"`
+
+	Gzip   bool `json:"gzip"`
+	Brotli bool `json:"brotli"`
+
+	ImageOptimization      bool                   `json:"image_optimization"`
+	ImageOptimizationLevel ImageOptimizationLevel `json:"image_optimization_level"`
+	// WebP serves optimized images as WebP to browsers accepting it
+	WebP bool `json:"webp"`
+
+	HTTP2 bool `json:"http2"`
+	HTTP3 bool `json:"http3"`
+}
+
+// AccelerationSettingsUpdateOptions fields are those accepted by UpdateAccelerationSettings.
+// Nil fields are not changed.
+type AccelerationSettingsUpdateOptions struct {
+	MinifyJS   *bool `json:"minify_js,omitempty"`
+	MinifyCSS  *bool `json:"minify_css,omitempty"`
+	MinifyHTML *bool `json:"minify_html,omitempty"`
+
+	Gzip   *bool `json:"gzip,omitempty"`
+	Brotli *bool `json:"brotli,omitempty"`
+
+	ImageOptimization      *bool                  `json:"image_optimization,omitempty"`
+	ImageOptimizationLevel ImageOptimizationLevel `json:"image_optimization_level,omitempty"`
+	WebP                   *bool                  `json:"webp,omitempty"`
+
+	HTTP2 *bool `json:"http2,omitempty"`
+	HTTP3 *bool `json:"http3,omitempty"`
+}
+
+// GetUpdateOptions converts AccelerationSettings to AccelerationSettingsUpdateOptions
+// setting every field, for use in UpdateAccelerationSettings
+func (s AccelerationSettings) GetUpdateOptions() AccelerationSettingsUpdateOptions {
+	return AccelerationSettingsUpdateOptions{
+		MinifyJS:               &s.MinifyJS,
+		MinifyCSS:              &s.MinifyCSS,
+		MinifyHTML:             &s.MinifyHTML,
+		Gzip:                   &s.Gzip,
+		Brotli:                 &s.Brotli,
+		ImageOptimization:      &s.ImageOptimization,
+		ImageOptimizationLevel: s.ImageOptimizationLevel,
+		WebP:                   &s.WebP,
+		HTTP2:                  &s.HTTP2,
+		HTTP3:                  &s.HTTP3,
+	}
+}
+
+// GetAccelerationSettings gets the content acceleration settings of a Domain
+func (c *Client) GetAccelerationSettings(ctx context.Context, domain string) (*AccelerationSettings, error) {
+	e, err := c.AccelerationSettings.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &AccelerationSettings{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(settings)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// UpdateAccelerationSettings updates the content acceleration settings of a Domain
+// and returns the resulting settings
+func (c *Client) UpdateAccelerationSettings(ctx context.Context, domain string, updateOpts AccelerationSettingsUpdateOptions) (*AccelerationSettings, error) {
+	e, err := c.AccelerationSettings.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	settings := &AccelerationSettings{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(settings)).SetBody(string(bodyData)).Patch(e)); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
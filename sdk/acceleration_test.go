@@ -0,0 +1,63 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAccelerationSettings(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		if r.Method == http.MethodGet {
+			writeJSON(w, http.StatusOK, `{"data":{"minify_js":true,"gzip":true,"image_optimization":true,"image_optimization_level":"lossless","http2":true}}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"data":{"minify_js":true,"gzip":true,"brotli":true,"image_optimization":true,"image_optimization_level":"lossy","http2":true}}`)
+	})
+
+	settings, err := client.GetAccelerationSettings(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("Error getting acceleration settings: %s", err)
+	}
+
+	expected := &AccelerationSettings{
+		MinifyJS:               true,
+		Gzip:                   true,
+		ImageOptimization:      true,
+		ImageOptimizationLevel: ImageOptimizationLossless,
+		HTTP2:                  true,
+	}
+	if !cmp.Equal(settings, expected) {
+		t.Error(cmp.Diff(settings, expected))
+	}
+
+	brotli := true
+	settings, err = client.UpdateAccelerationSettings(context.Background(), "example.com", AccelerationSettingsUpdateOptions{
+		Brotli:                 &brotli,
+		ImageOptimizationLevel: ImageOptimizationLossy,
+	})
+	if err != nil {
+		t.Fatalf("Error updating acceleration settings: %s", err)
+	}
+
+	if !settings.Brotli || settings.ImageOptimizationLevel != ImageOptimizationLossy {
+		t.Errorf("unexpected acceleration settings %#v", settings)
+	}
+
+	expectedRequests := []string{
+		"GET /cdn/4.0/domains/example.com/acceleration ",
+		`PATCH /cdn/4.0/domains/example.com/acceleration {"brotli":true,"image_optimization_level":"lossy"}`,
+	}
+	if !cmp.Equal(requests, expectedRequests) {
+		t.Error(cmp.Diff(requests, expectedRequests))
+	}
+}
@@ -0,0 +1,602 @@
+// Package arvantest provides an in-process fake of the Arvancloud CDN 4.0 API
+// for hermetic tests of code built on the sdk package.
+//
+// The fake keeps Domains, their DNS records and their settings (ddos,
+// acceleration, origin, ...) in memory, imports and exports records as zone
+// files, paginates lists with the same meta as the API and answers invalid
+// requests with API error payloads:
+//
+//	server := arvantest.NewServer()
+//	defer server.Close()
+//
+//	server.AddDomain("example.com")
+//	client := server.NewClient()
+//	records, err := client.ListDomainRecords(ctx, "example.com", nil)
+package arvantest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/S4eedb/arvancloud-go/sdk"
+	"github.com/S4eedb/arvancloud-go/sdk/zonefile"
+)
+
+// DefaultPerPage is the page size of lists requested without per_page
+const DefaultPerPage = 25
+
+// apiPrefix is the path the fake API is served under, matching sdk.APIVersion
+const apiPrefix = "/" + sdk.APIVersion + "/"
+
+// defaultSettings are the settings endpoints of every Domain added to the Server
+var defaultSettings = []string{"ddos", "acceleration", "origin", "caching", "ssl"}
+
+// Server is a fake Arvancloud API served by an httptest.Server.
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	// PerPage overrides DefaultPerPage when set
+	PerPage int
+
+	mu       sync.Mutex
+	domains  map[string]*domainState
+	faults   []*Fault
+	requests []string
+	nextID   int
+}
+
+type domainState struct {
+	domain   sdk.Domain
+	records  []sdk.DomainRecord
+	settings map[string]map[string]interface{}
+}
+
+// NewServer starts a Server without any Domains. Close it when done.
+func NewServer() *Server {
+	s := &Server{domains: map[string]*domainState{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// NewClient returns an sdk.Client pointed at the Server. Retries are made
+// without waiting so that injected faults do not slow tests down.
+func (s *Server) NewClient() *sdk.Client {
+	client := sdk.NewClient("Apikey arvantest")
+	client.SetBaseURL(s.URL)
+	client.SetRetryWaitTime(time.Millisecond)
+	client.SetRetryMaxWaitTime(time.Millisecond)
+
+	return &client
+}
+
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.nextID)
+}
+
+// AddDomain adds an active Domain with empty settings and no records, replacing
+// any Domain of the same name
+func (s *Server) AddDomain(name string) sdk.Domain {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addDomain(name)
+}
+
+func (s *Server) addDomain(name string) sdk.Domain {
+	now := time.Now().UTC().Truncate(time.Second)
+	domain := sdk.Domain{
+		ID:        s.newID(),
+		Domain:    name,
+		Name:      name,
+		Status:    "active",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	domain.Services.DNS = "cloud"
+
+	state := &domainState{domain: domain, settings: map[string]map[string]interface{}{}}
+	for _, name := range defaultSettings {
+		state.settings[name] = map[string]interface{}{}
+	}
+	s.domains[name] = state
+
+	return domain
+}
+
+// AddDomainRecord adds a DNS record to a Domain added with AddDomain, assigning
+// it an ID when it has none
+func (s *Server) AddDomainRecord(domain string, record sdk.DomainRecord) (sdk.DomainRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.domains[domain]
+	if !ok {
+		return record, fmt.Errorf("domain %q has not been added", domain)
+	}
+
+	if record.ID == "" {
+		record.ID = s.newID()
+	}
+	state.records = append(state.records, record)
+
+	return record, nil
+}
+
+// DomainRecords returns the DNS records of a Domain in the order they were created
+func (s *Server) DomainRecords(domain string) []sdk.DomainRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.domains[domain]
+	if !ok {
+		return nil
+	}
+
+	return append([]sdk.DomainRecord{}, state.records...)
+}
+
+// SetSettings replaces the settings of a Domain served at domains/{domain}/{name},
+// e.g. "ddos". settings must encode to a JSON object.
+func (s *Server) SetSettings(domain, name string, settings interface{}) error {
+	b, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(b, &values); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.domains[domain]
+	if !ok {
+		return fmt.Errorf("domain %q has not been added", domain)
+	}
+	state.settings[name] = values
+
+	return nil
+}
+
+// Requests returns the requests served so far as "METHOD path" relative to the API root,
+// including those answered by a Fault
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := strings.TrimPrefix(r.URL.Path, apiPrefix)
+	s.requests = append(s.requests, r.Method+" "+p)
+
+	if f := s.matchFault(r.Method, p); f != nil {
+		f.write(w)
+		return
+	}
+
+	if r.Header.Get("Authorization") == "" {
+		writeError(w, http.StatusUnauthorized, "Unauthenticated.")
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, apiPrefix) {
+		writeError(w, http.StatusNotFound, "Not found.")
+		return
+	}
+
+	parts := strings.Split(strings.Trim(p, "/"), "/")
+	if parts[0] != "domains" {
+		writeError(w, http.StatusNotFound, "Not found.")
+		return
+	}
+
+	switch {
+	case len(parts) == 1:
+		s.serveDomains(w, r)
+		return
+	case len(parts) == 2 && parts[1] == "dns-service":
+		s.serveCreateDomain(w, r)
+		return
+	case len(parts) >= 2:
+		state, ok := s.domains[parts[1]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "Domain not found.")
+			return
+		}
+
+		switch {
+		case len(parts) == 2:
+			s.serveDomain(w, r, state)
+		case parts[2] == "dns-records":
+			s.serveDomainRecords(w, r, state, parts[3:])
+		case len(parts) == 3 && parts[2] == "caching" && r.Method == http.MethodDelete:
+			// Purging is recorded in Requests, there is no cache to remove content from
+			writeData(w, http.StatusOK, nil)
+		case len(parts) == 3 && state.settings[parts[2]] != nil:
+			s.serveSettings(w, r, state, parts[2])
+		default:
+			writeError(w, http.StatusNotFound, "Not found.")
+		}
+	}
+}
+
+func (s *Server) serveDomains(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed.")
+		return
+	}
+
+	names := make([]string, 0, len(s.domains))
+	for name := range s.domains {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	domains := make([]sdk.Domain, 0, len(names))
+	for _, name := range names {
+		domains = append(domains, s.domains[name].domain)
+	}
+
+	s.writePage(w, r, len(domains), func(from, to int) interface{} {
+		return domains[from:to]
+	})
+}
+
+func (s *Server) serveCreateDomain(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed.")
+		return
+	}
+
+	createOpts := sdk.DomainCreateOptions{}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = json.Unmarshal(body, &createOpts)
+	}
+
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "The request body is not valid JSON.")
+		return
+	}
+
+	if createOpts.Domain == "" {
+		writeError(w, http.StatusUnprocessableEntity, "This field is required.", "domain")
+		return
+	}
+
+	if _, ok := s.domains[createOpts.Domain]; ok {
+		writeError(w, http.StatusUnprocessableEntity, "The domain has already been taken.", "domain")
+		return
+	}
+
+	writeData(w, http.StatusCreated, s.addDomain(createOpts.Domain))
+}
+
+func (s *Server) serveDomain(w http.ResponseWriter, r *http.Request, state *domainState) {
+	switch r.Method {
+	case http.MethodGet:
+		writeData(w, http.StatusOK, state.domain)
+	case http.MethodDelete:
+		delete(s.domains, state.domain.Domain)
+		writeData(w, http.StatusOK, nil)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed.")
+	}
+}
+
+func (s *Server) serveDomainRecords(w http.ResponseWriter, r *http.Request, state *domainState, parts []string) {
+	if len(parts) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			records := state.records
+			s.writePage(w, r, len(records), func(from, to int) interface{} {
+				return records[from:to]
+			})
+		case http.MethodPost:
+			record, ok := readRecord(w, r)
+			if !ok {
+				return
+			}
+			record.ID = s.newID()
+			state.records = append(state.records, record)
+			writeData(w, http.StatusCreated, record)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed.")
+		}
+
+		return
+	}
+
+	if len(parts) == 1 && parts[0] == "export" {
+		s.serveZoneExport(w, r, state)
+		return
+	}
+
+	if len(parts) == 1 && parts[0] == "import" {
+		s.serveZoneImport(w, r, state)
+		return
+	}
+
+	index := -1
+	for i, record := range state.records {
+		if record.ID == parts[0] {
+			index = i
+		}
+	}
+
+	if len(parts) > 1 || index < 0 {
+		writeError(w, http.StatusNotFound, "DNS record not found.")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeData(w, http.StatusOK, state.records[index])
+	case http.MethodPut:
+		record, ok := readRecord(w, r)
+		if !ok {
+			return
+		}
+
+		if record.Type != state.records[index].Type {
+			writeError(w, http.StatusUnprocessableEntity, "The type of a DNS record cannot be changed.", "type")
+			return
+		}
+		record.ID = state.records[index].ID
+		state.records[index] = record
+		writeData(w, http.StatusOK, record)
+	case http.MethodDelete:
+		state.records = append(state.records[:index], state.records[index+1:]...)
+		writeData(w, http.StatusOK, nil)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed.")
+	}
+}
+
+func (s *Server) serveZoneExport(w http.ResponseWriter, r *http.Request, state *domainState) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed.")
+		return
+	}
+
+	var zone bytes.Buffer
+	if err := zonefile.Write(&zone, state.domain.Domain, state.records); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write(zone.Bytes())
+}
+
+// serveZoneImport adds the records of the uploaded zone file that the Domain does not
+// have yet. With replace_existing, records that are not in the zone file are removed.
+func (s *Server) serveZoneImport(w http.ResponseWriter, r *http.Request, state *domainState) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed.")
+		return
+	}
+
+	file, _, err := r.FormFile("f_zone_file")
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "This field is required.", "f_zone_file")
+		return
+	}
+	defer file.Close()
+
+	imported, err := zonefile.Parse(file, state.domain.Domain)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error(), "f_zone_file")
+		return
+	}
+
+	cloud := r.FormValue("cloud") == "true"
+	kept := map[int]bool{}
+	var added []sdk.DomainRecord
+
+	for _, record := range imported {
+		switch record.Type {
+		case sdk.RecordTypeA, sdk.RecordTypeAAAA, sdk.RecordTypeCNAME:
+			record.Cloud = cloud
+		}
+
+		index := -1
+		for i, existing := range state.records {
+			if existing.Name == record.Name && existing.Type == record.Type && reflect.DeepEqual(existing.Value, record.Value) {
+				index = i
+			}
+		}
+
+		if index >= 0 {
+			kept[index] = true
+			continue
+		}
+
+		record.ID = s.newID()
+		added = append(added, record)
+	}
+
+	if r.FormValue("replace_existing") == "true" {
+		var remaining []sdk.DomainRecord
+		for i, record := range state.records {
+			if kept[i] {
+				remaining = append(remaining, record)
+			}
+		}
+		state.records = remaining
+	}
+	state.records = append(state.records, added...)
+
+	writeData(w, http.StatusOK, nil)
+}
+
+// readRecord decodes a DNS record from the request body, writing a validation error when it is invalid
+func readRecord(w http.ResponseWriter, r *http.Request) (sdk.DomainRecord, bool) {
+	record := sdk.DomainRecord{}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = json.Unmarshal(body, &record)
+	}
+
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "The request body is not valid JSON.")
+		return record, false
+	}
+
+	fields := []string{}
+	if record.Type == "" {
+		fields = append(fields, "type")
+	}
+	if record.Name == "" {
+		fields = append(fields, "name")
+	}
+	if record.Value == nil {
+		fields = append(fields, "value")
+	}
+
+	if len(fields) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "This field is required.", fields...)
+		return record, false
+	}
+
+	if record.TTL == 0 {
+		record.TTL = 120
+	}
+
+	return record, true
+}
+
+func (s *Server) serveSettings(w http.ResponseWriter, r *http.Request, state *domainState, name string) {
+	switch r.Method {
+	case http.MethodGet:
+		writeData(w, http.StatusOK, state.settings[name])
+	case http.MethodPut, http.MethodPatch:
+		values := map[string]interface{}{}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err == nil {
+			err = json.Unmarshal(body, &values)
+		}
+
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "The request body is not a valid JSON object.")
+			return
+		}
+
+		if r.Method == http.MethodPut {
+			state.settings[name] = values
+		} else {
+			for k, v := range values {
+				state.settings[name][k] = v
+			}
+		}
+
+		writeData(w, http.StatusOK, state.settings[name])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed.")
+	}
+}
+
+// writePage writes the page of a list requested by the page and per_page query parameters.
+// slice returns the items between from (inclusive) and to (exclusive).
+func (s *Server) writePage(w http.ResponseWriter, r *http.Request, total int, slice func(from, to int) interface{}) {
+	perPage := s.PerPage
+	if perPage <= 0 {
+		perPage = DefaultPerPage
+	}
+
+	if v, err := strconv.Atoi(r.URL.Query().Get("per_page")); err == nil && v > 0 {
+		perPage = v
+	}
+
+	page := 1
+	if v, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil && v > 0 {
+		page = v
+	}
+
+	lastPage := (total + perPage - 1) / perPage
+	if lastPage == 0 {
+		lastPage = 1
+	}
+
+	from := (page - 1) * perPage
+	if from > total {
+		from = total
+	}
+
+	to := from + perPage
+	if to > total {
+		to = total
+	}
+
+	meta := sdk.Meta{
+		CurrentPage: page,
+		LastPage:    lastPage,
+		Path:        "http://" + r.Host + r.URL.Path,
+		PerPage:     perPage,
+		Total:       total,
+	}
+
+	// from and to are 1-based in meta, and zero for an empty page
+	if to > from {
+		meta.From = from + 1
+		meta.To = to
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": slice(from, to),
+		"meta": meta,
+	})
+}
+
+func writeData(w http.ResponseWriter, status int, data interface{}) {
+	writeJSON(w, status, map[string]interface{}{"data": data})
+}
+
+// writeError writes an API error payload with one reason per field, or a single
+// reason without a field when no fields are given
+func writeError(w http.ResponseWriter, status int, reason string, fields ...string) {
+	writeJSON(w, status, errorPayload(reason, fields...))
+}
+
+func errorPayload(reason string, fields ...string) sdk.APIError {
+	if len(fields) == 0 {
+		return sdk.APIError{Errors: []sdk.APIErrorReason{{Reason: reason}}}
+	}
+
+	apiError := sdk.APIError{}
+	for _, field := range fields {
+		apiError.Errors = append(apiError.Errors, sdk.APIErrorReason{Reason: reason, Field: field})
+	}
+
+	return apiError
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
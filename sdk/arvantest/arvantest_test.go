@@ -0,0 +1,170 @@
+package arvantest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/S4eedb/arvancloud-go/sdk"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+
+	server := NewServer()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestServer_pagination(t *testing.T) {
+	server := newTestServer(t)
+	server.PerPage = 2
+	server.AddDomain("example.com")
+
+	for i := 0; i < 5; i++ {
+		if _, err := server.AddDomainRecord("example.com", sdk.DomainRecord{
+			Type:  sdk.RecordTypeTXT,
+			Name:  fmt.Sprintf("r%d", i),
+			Value: sdk.TXTRecordValue{Text: "hello"},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	client := server.NewClient()
+
+	records, err := client.ListDomainRecords(context.Background(), "example.com", nil)
+	if err != nil {
+		t.Fatalf("Error listing records: %s", err)
+	}
+
+	if len(records) != 5 || records[4].Name != "r4" {
+		t.Errorf("expected all 5 records, got %#v", records)
+	}
+
+	opts := sdk.NewListOptions(3)
+	records, err = client.ListDomainRecords(context.Background(), "example.com", opts)
+	if err != nil {
+		t.Fatalf("Error listing records: %s", err)
+	}
+
+	if len(records) != 1 || records[0].Name != "r4" {
+		t.Errorf("expected the last record only, got %#v", records)
+	}
+
+	if opts.Meta.Total != 5 || opts.Meta.LastPage != 3 {
+		t.Errorf("unexpected meta %#v", opts.Meta)
+	}
+}
+
+func TestServer_domainRecords(t *testing.T) {
+	server := newTestServer(t)
+	server.AddDomain("example.com")
+	client := server.NewClient()
+
+	record, err := client.CreateDomainRecord(context.Background(), "example.com", sdk.DomainRecordCreateOptions{
+		Type:  sdk.RecordTypeCNAME,
+		Name:  "www",
+		Value: sdk.HostRecordValue{Host: "example.net"},
+	})
+	if err != nil {
+		t.Fatalf("Error creating record: %s", err)
+	}
+
+	updateOpts := record.GetUpdateOptions()
+	updateOpts.Value = sdk.HostRecordValue{Host: "example.org"}
+	if _, err := client.UpdateDomainRecord(context.Background(), "example.com", record.ID, updateOpts); err != nil {
+		t.Fatalf("Error updating record: %s", err)
+	}
+
+	record, err = client.GetDomainRecord(context.Background(), "example.com", record.ID)
+	if err != nil {
+		t.Fatalf("Error getting record: %s", err)
+	}
+
+	if !cmp.Equal(record.Value, sdk.HostRecordValue{Host: "example.org"}) || record.TTL != 120 {
+		t.Errorf("unexpected record %#v", record)
+	}
+
+	if err := client.DeleteDomainRecord(context.Background(), "example.com", record.ID); err != nil {
+		t.Fatalf("Error deleting record: %s", err)
+	}
+
+	if records := server.DomainRecords("example.com"); len(records) != 0 {
+		t.Errorf("expected no records left, got %#v", records)
+	}
+}
+
+func TestServer_errors(t *testing.T) {
+	server := newTestServer(t)
+	server.AddDomain("example.com")
+	client := server.NewClient()
+
+	_, err := client.GetDomainRecord(context.Background(), "example.com", "missing")
+	if e := (&sdk.Error{}); !errors.As(err, &e) || e.Code != http.StatusNotFound {
+		t.Errorf("expected a 404 error, got %v", err)
+	}
+
+	_, err = client.CreateDomainRecord(context.Background(), "example.com", sdk.DomainRecordCreateOptions{Type: sdk.RecordTypeA})
+	if expected := "[422] [name] This field is required.; [value] This field is required."; err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+
+	if _, err := client.ListDomainRecords(context.Background(), "missing.com", nil); err == nil {
+		t.Error("expected error listing records of an unknown domain")
+	}
+}
+
+func TestServer_settings(t *testing.T) {
+	server := newTestServer(t)
+	server.AddDomain("example.com")
+	client := server.NewClient()
+
+	if err := server.SetSettings("example.com", "origin", sdk.OriginSettings{Protocol: sdk.OriginProtocolHTTP, Port: 80}); err != nil {
+		t.Fatal(err)
+	}
+
+	settings, err := client.UpdateOriginSettings(context.Background(), "example.com", sdk.OriginSettingsUpdateOptions{
+		Protocol: sdk.OriginProtocolHTTPS,
+	})
+	if err != nil {
+		t.Fatalf("Error updating origin settings: %s", err)
+	}
+
+	if settings.Protocol != sdk.OriginProtocolHTTPS || settings.Port != 80 {
+		t.Errorf("expected only the protocol to change, got %#v", settings)
+	}
+}
+
+func TestServer_faults(t *testing.T) {
+	server := newTestServer(t)
+	server.AddDomain("example.com")
+	client := server.NewClient()
+
+	server.InjectFault(TooManyRequests(1))
+	server.InjectFault(ServiceUnavailable())
+
+	if _, err := client.ListDomains(context.Background(), nil); err != nil {
+		t.Fatalf("expected retries to succeed, got %s", err)
+	}
+
+	expected := []string{"GET domains", "GET domains", "GET domains"}
+	if requests := server.Requests(); !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+
+	server.InjectFault(Maintenance())
+
+	_, err := client.ListDomains(context.Background(), nil)
+	if e := (&sdk.Error{}); !errors.As(err, &e) || e.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected a 503 error, got %v", err)
+	}
+
+	if requests := server.Requests(); len(requests) != 4 {
+		t.Errorf("expected maintenance responses not to be retried, got %v", requests)
+	}
+}
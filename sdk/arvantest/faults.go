@@ -0,0 +1,109 @@
+package arvantest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Fault is an error response the Server answers matching requests with instead of serving them
+type Fault struct {
+	// Method matches requests of a method, all methods when empty
+	Method string
+	// Path matches requests whose path relative to the API root starts with it, e.g.
+	// "domains/example.com/dns-records"; all paths when empty
+	Path string
+
+	StatusCode int
+	Header     http.Header
+	Reason     string
+
+	// Times is how many requests the Fault answers before it is removed;
+	// zero answers every matching request until ClearFaults is called
+	Times int
+}
+
+// TooManyRequests returns a Fault answering once with 429 Too Many Requests and
+// a Retry-After header of retryAfter seconds
+func TooManyRequests(retryAfter int) Fault {
+	return Fault{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{strconv.Itoa(retryAfter)}},
+		Reason:     "Too Many Attempts.",
+		Times:      1,
+	}
+}
+
+// ServiceUnavailable returns a Fault answering once with 503 Service Unavailable
+func ServiceUnavailable() Fault {
+	return Fault{
+		StatusCode: http.StatusServiceUnavailable,
+		Reason:     "Service Unavailable.",
+		Times:      1,
+	}
+}
+
+// Maintenance returns a Fault answering every request with the 503 and
+// X-Maintenance-Mode header sent during API maintenance, which clients do not retry
+func Maintenance() Fault {
+	return Fault{
+		StatusCode: http.StatusServiceUnavailable,
+		Header:     http.Header{"X-Maintenance-Mode": []string{"1"}},
+		Reason:     "Arvancloud API is under maintenance.",
+	}
+}
+
+// InjectFault adds a Fault. Faults are matched in the order they were injected.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all injected Faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// matchFault returns the first Fault matching a request, consuming one of its Times
+func (s *Server) matchFault(method, path string) *Fault {
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != method {
+			continue
+		}
+
+		if !strings.HasPrefix(path, f.Path) {
+			continue
+		}
+
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+
+		return f
+	}
+
+	return nil
+}
+
+func (f *Fault) write(w http.ResponseWriter) {
+	for k, values := range f.Header {
+		for _, v := range values {
+			w.Header().Add(k, v)
+		}
+	}
+
+	reason := f.Reason
+	if reason == "" {
+		reason = http.StatusText(f.StatusCode)
+	}
+
+	writeError(w, f.StatusCode, reason)
+}
@@ -0,0 +1,117 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// CacheStatus is which requests the CDN caches responses for
+type CacheStatus string
+
+// CacheStatus enums
+const (
+	CacheStatusOff CacheStatus = "off"
+	// CacheStatusURI caches by URI, ignoring the query string
+	CacheStatusURI CacheStatus = "uri"
+	// CacheStatusQueryString caches by URI and query string
+	CacheStatusQueryString CacheStatus = "query_string"
+	// CacheStatusAdvance caches by URI, query string and the cookies of the request
+	CacheStatusAdvance CacheStatus = "advance"
+)
+
+// CachePurgeType is what PurgeCache removes from the cache
+type CachePurgeType string
+
+// CachePurgeType enums
+const (
+	CachePurgeAll        CachePurgeType = "all"
+	CachePurgeIndividual CachePurgeType = "individual"
+)
+
+// CacheSettings represents the caching settings of a Domain
+type CacheSettings struct {
+	Status CacheStatus `json:"cache_status"`
+	// DeveloperMode bypasses the cache for all requests
+	DeveloperMode bool `json:"cache_developer_mode"`
+	// ConsistentUptime serves stale content while the origin is down
+	ConsistentUptime bool `json:"cache_consistent_uptime"`
+	// PageTTL and BrowserTTL are durations such as "1h" or "off"
+	PageTTL    string `json:"cache_page_200"`
+	BrowserTTL string `json:"cache_browser"`
+}
+
+// CacheSettingsUpdateOptions fields are those accepted by UpdateCacheSettings.
+// Nil and empty fields are not changed.
+type CacheSettingsUpdateOptions struct {
+	Status           CacheStatus `json:"cache_status,omitempty"`
+	DeveloperMode    *bool       `json:"cache_developer_mode,omitempty"`
+	ConsistentUptime *bool       `json:"cache_consistent_uptime,omitempty"`
+	PageTTL          string      `json:"cache_page_200,omitempty"`
+	BrowserTTL       string      `json:"cache_browser,omitempty"`
+}
+
+// CachePurgeOptions fields are those accepted by PurgeCache
+type CachePurgeOptions struct {
+	Purge CachePurgeType `json:"-"`
+	// URLs are the absolute URLs purged by CachePurgeIndividual
+	URLs []string `json:"purge_urls,omitempty"`
+}
+
+// GetCacheSettings gets the caching settings of a Domain
+func (c *Client) GetCacheSettings(ctx context.Context, domain string) (*CacheSettings, error) {
+	e, err := c.Caching.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &CacheSettings{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(settings)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// UpdateCacheSettings updates the caching settings of a Domain and returns the resulting settings
+func (c *Client) UpdateCacheSettings(ctx context.Context, domain string, updateOpts CacheSettingsUpdateOptions) (*CacheSettings, error) {
+	e, err := c.Caching.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	settings := &CacheSettings{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(settings)).SetBody(string(bodyData)).Patch(e)); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// PurgeCache removes cached content of a Domain, all of it or the given URLs
+func (c *Client) PurgeCache(ctx context.Context, domain string, opts CachePurgeOptions) error {
+	e, err := c.Caching.endpointWithParams(domain)
+	if err != nil {
+		return err
+	}
+
+	purge := opts.Purge
+	if purge == "" {
+		purge = CachePurgeAll
+		if len(opts.URLs) > 0 {
+			purge = CachePurgeIndividual
+		}
+	}
+
+	bodyData, err := json.Marshal(opts)
+	if err != nil {
+		return NewError(err)
+	}
+
+	_, err = coupleAPIErrors(c.R(ctx).SetQueryParam("purge", string(purge)).SetBody(string(bodyData)).Delete(e))
+	return err
+}
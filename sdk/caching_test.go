@@ -0,0 +1,86 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCacheSettings(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		if r.Method == http.MethodGet {
+			writeJSON(w, http.StatusOK, `{"data":{"cache_status":"uri","cache_developer_mode":true,"cache_page_200":"1h","cache_browser":"off"}}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"data":{"cache_status":"query_string","cache_developer_mode":false,"cache_page_200":"1h","cache_browser":"off"}}`)
+	})
+
+	settings, err := client.GetCacheSettings(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("Error getting cache settings: %s", err)
+	}
+
+	expected := &CacheSettings{Status: CacheStatusURI, DeveloperMode: true, PageTTL: "1h", BrowserTTL: "off"}
+	if !cmp.Equal(settings, expected) {
+		t.Error(cmp.Diff(settings, expected))
+	}
+
+	developerMode := false
+	settings, err = client.UpdateCacheSettings(context.Background(), "example.com", CacheSettingsUpdateOptions{
+		Status:        CacheStatusQueryString,
+		DeveloperMode: &developerMode,
+	})
+	if err != nil {
+		t.Fatalf("Error updating cache settings: %s", err)
+	}
+
+	if settings.Status != CacheStatusQueryString || settings.DeveloperMode {
+		t.Errorf("unexpected cache settings %#v", settings)
+	}
+
+	expectedRequests := []string{
+		"GET /cdn/4.0/domains/example.com/caching ",
+		`PATCH /cdn/4.0/domains/example.com/caching {"cache_status":"query_string","cache_developer_mode":false}`,
+	}
+	if !cmp.Equal(requests, expectedRequests) {
+		t.Error(cmp.Diff(requests, expectedRequests))
+	}
+}
+
+func TestPurgeCache(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s?%s %s", r.Method, r.URL.Path, r.URL.RawQuery, body))
+
+		writeJSON(w, http.StatusOK, `{"message":"Cache purged."}`)
+	})
+
+	ctx := context.Background()
+
+	if err := client.PurgeCache(ctx, "example.com", CachePurgeOptions{}); err != nil {
+		t.Fatalf("Error purging cache: %s", err)
+	}
+
+	if err := client.PurgeCache(ctx, "example.com", CachePurgeOptions{URLs: []string{"https://example.com/a.css"}}); err != nil {
+		t.Fatalf("Error purging URLs: %s", err)
+	}
+
+	expected := []string{
+		"DELETE /cdn/4.0/domains/example.com/caching?purge=all {}",
+		`DELETE /cdn/4.0/domains/example.com/caching?purge=individual {"purge_urls":["https://example.com/a.css"]}`,
+	}
+	if !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+}
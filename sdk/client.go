@@ -2,9 +2,11 @@ package sdk
 
 import (
 	"context"
-	"fmt"
+	"crypto/tls"
+	"crypto/x509"
 	"io/ioutil"
 	"log"
+	"net/http"
 	"net/url"
 	"os"
 	"path"
@@ -28,6 +30,10 @@ const (
 	APIVersionVar = "ARVANCLOUD_API_VERSION"
 	// APIProto connect to API with http(s)
 	APIProto = "https"
+	// FixtureModeVar environment var to record API exchanges to, or replay them from, a fixture file: "record" or "replay"
+	FixtureModeVar = "ARVANCLOUD_FIXTURE_MODE"
+	// FixturePathVar environment var containing the path of the fixture file
+	FixturePathVar = "ARVANCLOUD_FIXTURE_PATH"
 	// APIEnvVar environment var to check for API token
 	APIEnvVar = "ARVANCLOUD_TOKEN"
 	// APISecondsPerPoll how frequently to poll for new Events or Status in WaitFor functions
@@ -43,16 +49,52 @@ type Client struct {
 	resources         map[string]*Resource
 	debug             bool
 	retryConditionals []RetryConditional
+	dryRun            *dryRunTransport
+	credentials       *credentialsState
 
 	millisecondsPerPoll time.Duration
 
-	baseURL    string
-	apiVersion string
-	apiProto   string
-
-	Domains       *Resource
-	DomainRecords *Resource
-	Account       *Resource
+	services map[Product]*Service
+
+	CDN               *Service
+	IaaS              *Service
+	VOD               *Service
+	Live              *Service
+	ContainerRegistry *Service
+
+	Domains              *Resource
+	DomainRecords        *Resource
+	Account              *Resource
+	WAFPackages          *Resource
+	WAFRules             *Resource
+	WAFExclusions        *Resource
+	DDoSSettings         *Resource
+	DDoSRules            *Resource
+	RateLimitRules       *Resource
+	PageRules            *Resource
+	LoadBalancerPools    *Resource
+	LoadBalancerOrigins  *Resource
+	HealthChecks         *Resource
+	Reports              *Resource
+	LogForwarders        *Resource
+	CustomPages          *Resource
+	AccelerationSettings *Resource
+	OriginSettings       *Resource
+	HeaderRules          *Resource
+	DNSSEC               *Resource
+	Caching              *Resource
+	SSLSettings          *Resource
+
+	Regions *Resource
+	Servers *Resource
+	Images  *Resource
+	Flavors *Resource
+
+	SecurityGroups     *Resource
+	SecurityGroupRules *Resource
+	FloatingIPs        *Resource
+	Networks           *Resource
+	Subnets            *Resource
 }
 
 // R wraps resty's R method
@@ -77,11 +119,25 @@ func (c *Client) SetUserAgent(apkiKey string) *Client {
 	return c
 }
 
+// SetBaseURL sets the URL of the API host, shared by the services of all products
 func (c *Client) SetBaseURL(baseURL string) *Client {
 	baseURLPath, _ := url.Parse(baseURL)
 
-	c.baseURL = path.Join(baseURLPath.Host, baseURLPath.Path)
-	c.apiProto = baseURLPath.Scheme
+	apiProto := APIProto
+	host := APIHost
+
+	if baseURLPath.Scheme != "" {
+		apiProto = baseURLPath.Scheme
+	}
+
+	if h := path.Join(baseURLPath.Host, baseURLPath.Path); h != "" {
+		host = h
+	}
+
+	for _, s := range c.services {
+		s.apiProto = apiProto
+		s.host = host
+	}
 
 	c.updateHostURL()
 
@@ -91,6 +147,10 @@ func (c *Client) SetBaseURL(baseURL string) *Client {
 func NewClient(apikey string) (client Client) {
 
 	client.resty = resty.New()
+	addServices(&client)
+	client.dryRun = &dryRunTransport{resty: client.resty}
+	client.credentials = &credentialsState{}
+	client.resty.OnBeforeRequest(client.credentials.authorize)
 
 	client.SetAuthHeader(DefaultUserAgent, apikey)
 	baseURL, baseURLExists := os.LookupEnv(APIHostVar)
@@ -127,6 +187,16 @@ func NewClient(apikey string) (client Client) {
 		SetRetries().
 		SetDebug(envDebug)
 
+	fixtureMode, fixtureModeExists := os.LookupEnv(FixtureModeVar)
+
+	if fixtureModeExists {
+		fixturePath := os.Getenv(FixturePathVar)
+
+		if err := client.SetFixtures(FixtureMode(fixtureMode), fixturePath); err != nil {
+			log.Fatalf("[ERROR] Error when setting up fixtures at %s: %s\n", fixturePath, err.Error())
+		}
+	}
+
 	addResources(&client)
 
 	return
@@ -155,6 +225,39 @@ func addResources(client *Client) {
 	resources := map[string]*Resource{
 		accountName: NewResource(client, accountName, accountEndpoint, false, Account{}, nil),
 		domainsName: NewResource(client, domainsName, domainsEndpoint, false, Domain{}, DomainsPagedResponse{}),
+
+		domainRecordsName: NewResource(client, domainRecordsName, domainRecordsEndpoint, true, DomainRecord{}, DomainRecordsPagedResponse{}),
+
+		wafPackagesName:          NewResource(client, wafPackagesName, wafPackagesEndpoint, true, WAFPackage{}, WAFPackagesPagedResponse{}),
+		wafRulesName:             NewResource(client, wafRulesName, wafRulesEndpoint, true, WAFRule{}, WAFRulesPagedResponse{}),
+		wafExclusionsName:        NewResource(client, wafExclusionsName, wafExclusionsEndpoint, true, WAFExclusion{}, WAFExclusionsPagedResponse{}),
+		ddosSettingsName:         NewResource(client, ddosSettingsName, ddosSettingsEndpoint, true, DDoSSettings{}, nil),
+		ddosRulesName:            NewResource(client, ddosRulesName, ddosRulesEndpoint, true, DDoSRule{}, DDoSRulesPagedResponse{}),
+		rateLimitRulesName:       NewResource(client, rateLimitRulesName, rateLimitRulesEndpoint, true, RateLimitRule{}, RateLimitRulesPagedResponse{}),
+		pageRulesName:            NewResource(client, pageRulesName, pageRulesEndpoint, true, PageRule{}, PageRulesPagedResponse{}),
+		loadBalancerPoolsName:    NewResource(client, loadBalancerPoolsName, loadBalancerPoolsEndpoint, true, LoadBalancerPool{}, LoadBalancerPoolsPagedResponse{}),
+		loadBalancerOriginsName:  NewResource(client, loadBalancerOriginsName, loadBalancerOriginsEndpoint, true, LoadBalancerOrigin{}, LoadBalancerOriginsPagedResponse{}),
+		healthChecksName:         NewResource(client, healthChecksName, healthChecksEndpoint, true, HealthCheck{}, HealthChecksPagedResponse{}),
+		reportsName:              NewResource(client, reportsName, reportsEndpoint, true, TrafficReport{}, nil),
+		logForwardersName:        NewResource(client, logForwardersName, logForwardersEndpoint, true, LogForwarder{}, LogForwardersPagedResponse{}),
+		customPagesName:          NewResource(client, customPagesName, customPagesEndpoint, true, CustomPage{}, nil),
+		accelerationSettingsName: NewResource(client, accelerationSettingsName, accelerationSettingsEndpoint, true, AccelerationSettings{}, nil),
+		originSettingsName:       NewResource(client, originSettingsName, originSettingsEndpoint, true, OriginSettings{}, nil),
+		headerRulesName:          NewResource(client, headerRulesName, headerRulesEndpoint, true, HeaderRule{}, HeaderRulesPagedResponse{}),
+		dnssecName:               NewResource(client, dnssecName, dnssecEndpoint, true, DNSSEC{}, nil),
+		cachingName:              NewResource(client, cachingName, cachingEndpoint, true, CacheSettings{}, nil),
+		sslSettingsName:          NewResource(client, sslSettingsName, sslSettingsEndpoint, true, SSLSettings{}, nil),
+
+		regionsName: NewServiceResource(client.IaaS, regionsName, regionsEndpoint, false, Region{}, RegionsPagedResponse{}),
+		serversName: NewServiceResource(client.IaaS, serversName, serversEndpoint, true, Server{}, ServersPagedResponse{}),
+		imagesName:  NewServiceResource(client.IaaS, imagesName, imagesEndpoint, true, Image{}, ImagesPagedResponse{}),
+		flavorsName: NewServiceResource(client.IaaS, flavorsName, flavorsEndpoint, true, Flavor{}, FlavorsPagedResponse{}),
+
+		securityGroupsName:     NewServiceResource(client.IaaS, securityGroupsName, securityGroupsEndpoint, true, SecurityGroup{}, SecurityGroupsPagedResponse{}),
+		securityGroupRulesName: NewServiceResource(client.IaaS, securityGroupRulesName, securityGroupRulesEndpoint, true, SecurityGroupRule{}, SecurityGroupRulesPagedResponse{}),
+		floatingIPsName:        NewServiceResource(client.IaaS, floatingIPsName, floatingIPsEndpoint, true, FloatingIP{}, FloatingIPsPagedResponse{}),
+		networksName:           NewServiceResource(client.IaaS, networksName, networksEndpoint, true, Network{}, NetworksPagedResponse{}),
+		subnetsName:            NewServiceResource(client.IaaS, subnetsName, subnetsEndpoint, true, Subnet{}, SubnetsPagedResponse{}),
 	}
 
 	client.resources = resources
@@ -163,6 +266,36 @@ func addResources(client *Client) {
 	client.DomainRecords = resources[domainRecordsName]
 	client.Domains = resources[domainsName]
 
+	client.WAFPackages = resources[wafPackagesName]
+	client.WAFRules = resources[wafRulesName]
+	client.WAFExclusions = resources[wafExclusionsName]
+	client.DDoSSettings = resources[ddosSettingsName]
+	client.DDoSRules = resources[ddosRulesName]
+	client.RateLimitRules = resources[rateLimitRulesName]
+	client.PageRules = resources[pageRulesName]
+	client.LoadBalancerPools = resources[loadBalancerPoolsName]
+	client.LoadBalancerOrigins = resources[loadBalancerOriginsName]
+	client.HealthChecks = resources[healthChecksName]
+	client.Reports = resources[reportsName]
+	client.LogForwarders = resources[logForwardersName]
+	client.CustomPages = resources[customPagesName]
+	client.AccelerationSettings = resources[accelerationSettingsName]
+	client.OriginSettings = resources[originSettingsName]
+	client.HeaderRules = resources[headerRulesName]
+	client.DNSSEC = resources[dnssecName]
+	client.Caching = resources[cachingName]
+	client.SSLSettings = resources[sslSettingsName]
+
+	client.Regions = resources[regionsName]
+	client.Servers = resources[serversName]
+	client.Images = resources[imagesName]
+	client.Flavors = resources[flavorsName]
+
+	client.SecurityGroups = resources[securityGroupsName]
+	client.SecurityGroupRules = resources[securityGroupRulesName]
+	client.FloatingIPs = resources[floatingIPsName]
+	client.Networks = resources[networksName]
+	client.Subnets = resources[subnetsName]
 }
 
 func (c *Client) SetRetries() *Client {
@@ -171,6 +304,7 @@ func (c *Client) SetRetries() *Client {
 		addRetryConditional(tooManyRequestsRetryCondition).
 		addRetryConditional(serviceUnavailableRetryCondition).
 		addRetryConditional(requestTimeoutRetryCondition).
+		addRetryConditional(c.credentials.rotatedRetryCondition).
 		SetRetryMaxWaitTime(APIRetryMaxWaitTime)
 	configureRetries(c)
 	return c
@@ -200,37 +334,68 @@ func (c *Client) SetRetryWaitTime(min time.Duration) *Client {
 	return c
 }
 
-// SetRootCertificate adds a root certificate to the underlying TLS client config
+// SetRootCertificate adds a root certificate to the underlying TLS client config.
+// It may be called after SetDryRun or SetFixtures, which wrap the transport.
 func (c *Client) SetRootCertificate(path string) *Client {
-	c.resty.SetRootCertificate(path)
-	return c
-}
+	rt := c.resty.GetClient().Transport
+	if _, ok := rt.(*http.Transport); ok {
+		c.resty.SetRootCertificate(path)
+		return c
+	}
 
-// SetAPIVersion sets the version of the API to interface with
-func (c *Client) SetAPIVersion(apiVersion string) *Client {
-	c.apiVersion = apiVersion
+	transport := unwrapTransport(rt)
+	if transport == nil {
+		// Let resty report the transport it cannot configure
+		c.resty.SetRootCertificate(path)
+		return c
+	}
 
-	c.updateHostURL()
+	cert, err := ioutil.ReadFile(path)
+	if err != nil {
+		log.Printf("[ERROR] Error when reading cert at %s: %s\n", path, err.Error())
+		return c
+	}
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	if transport.TLSClientConfig.RootCAs == nil {
+		transport.TLSClientConfig.RootCAs = x509.NewCertPool()
+	}
+	transport.TLSClientConfig.RootCAs.AppendCertsFromPEM(cert)
 
 	return c
 }
 
-func (c *Client) updateHostURL() {
-	apiProto := APIProto
-	baseURL := APIHost
-	apiVersion := APIVersion
-
-	if c.baseURL != "" {
-		baseURL = c.baseURL
+// unwrapTransport returns the *http.Transport wrapped by the dry-run and fixture
+// transports, or nil if there is none the Client owns
+func unwrapTransport(rt http.RoundTripper) *http.Transport {
+	for {
+		switch t := rt.(type) {
+		case *dryRunTransport:
+			rt = t.next
+		case *fixtureTransport:
+			rt = t.next
+		case *http.Transport:
+			if rt == http.DefaultTransport {
+				return nil
+			}
+			return t
+		default:
+			return nil
+		}
 	}
+}
 
-	if c.apiVersion != "" {
-		apiVersion = c.apiVersion
-	}
+// SetAPIVersion sets the version of the CDN API to interface with, i.e. the base path
+// of the CDN service. The services of other products have their own, see Service.SetBasePath.
+func (c *Client) SetAPIVersion(apiVersion string) *Client {
+	c.CDN.SetBasePath(apiVersion)
 
-	if c.apiProto != "" {
-		apiProto = c.apiProto
-	}
+	return c
+}
 
-	c.resty.SetBaseURL(fmt.Sprintf("%s://%s/%s", apiProto, baseURL, apiVersion))
+// updateHostURL points resty at the CDN service, the endpoints of its resources are relative to it
+func (c *Client) updateHostURL() {
+	c.resty.SetBaseURL(c.CDN.BaseURL())
 }
@@ -2,6 +2,8 @@ package sdk
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -58,3 +60,23 @@ func TestClient_SetAPIVersion(t *testing.T) {
 		t.Fatal(cmp.Diff(client.resty.HostURL, expectedHost))
 	}
 }
+
+// newMockClient returns a Client pointed at an httptest.Server serving handler
+func newMockClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient("MYFAKEAPIKEY")
+	client.SetBaseURL(server.URL)
+
+	return &client
+}
+
+// writeJSON writes body to w as an application/json response
+func writeJSON(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprint(w, body)
+}
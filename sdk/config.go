@@ -0,0 +1,270 @@
+package sdk
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ConfigPathVar environment var containing the path of the config file
+	ConfigPathVar = "ARVANCLOUD_CONFIG"
+	// ProfileVar environment var selecting the config file profile used by NewClientFromEnv
+	ProfileVar = "ARVANCLOUD_PROFILE"
+	// DefaultProfile is the profile used by NewClientFromEnv when no profile is selected
+	// and ARVANCLOUD_TOKEN is not set
+	DefaultProfile = "default"
+)
+
+// Profile is a named set of credentials and settings of the config file. Empty
+// settings keep the defaults of NewClient.
+//
+// The config file has a table per profile:
+//
+//	[default]
+//	api_key = "Apikey ..."
+//
+//	[staging]
+//	api_key = "Apikey ..."
+//	base_url = "https://napi.staging.example.com"
+//	api_version = "cdn/4.0"
+//	ca_path = "~/certs/staging.pem"
+type Profile struct {
+	Name       string
+	APIKey     string
+	BaseURL    string
+	APIVersion string
+	// CAPath is the path of a CA certificate to validate the API against
+	CAPath string
+}
+
+// DefaultConfigPath returns the path of the config file:
+// ARVANCLOUD_CONFIG if set, otherwise $XDG_CONFIG_HOME/arvancloud/config.toml
+// or ~/.config/arvancloud/config.toml
+func DefaultConfigPath() (string, error) {
+	if p, ok := os.LookupEnv(ConfigPathVar); ok {
+		return p, nil
+	}
+
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+
+	return filepath.Join(dir, "arvancloud", "config.toml"), nil
+}
+
+// ParseConfig reads the profiles of a config file from r. It understands the
+// subset of TOML the config file uses: a table per profile holding string keys.
+func ParseConfig(r io.Reader) (map[string]Profile, error) {
+	profiles := map[string]Profile{}
+
+	var profile *Profile
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") {
+			end := strings.Index(text, "]")
+			if end < 0 || !isTOMLComment(text[end+1:]) {
+				return nil, fmt.Errorf("line %d: invalid table header", line)
+			}
+
+			name := strings.TrimSpace(text[1:end])
+			if unquoted, err := strconv.Unquote(name); err == nil {
+				name = unquoted
+			}
+
+			if profile != nil {
+				profiles[profile.Name] = *profile
+			}
+
+			if _, ok := profiles[name]; ok {
+				return nil, fmt.Errorf("line %d: profile %q defined twice", line, name)
+			}
+
+			profile = &Profile{Name: name}
+			continue
+		}
+
+		kv := strings.SplitN(text, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("line %d: expected key = value", line)
+		}
+
+		if profile == nil {
+			return nil, fmt.Errorf("line %d: key outside of a profile table", line)
+		}
+
+		key := strings.TrimSpace(kv[0])
+		value, err := parseTOMLString(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s: %w", line, key, err)
+		}
+
+		switch key {
+		case "api_key":
+			profile.APIKey = value
+		case "base_url":
+			profile.BaseURL = value
+		case "api_version":
+			profile.APIVersion = value
+		case "ca_path":
+			profile.CAPath = value
+		default:
+			return nil, fmt.Errorf("line %d: unknown key %q", line, key)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if profile != nil {
+		profiles[profile.Name] = *profile
+	}
+
+	return profiles, nil
+}
+
+// parseTOMLString parses a basic ("...") or literal ('...') TOML string followed by an optional comment
+func parseTOMLString(s string) (string, error) {
+	if strings.HasPrefix(s, "'") {
+		end := strings.Index(s[1:], "'")
+		if end < 0 || !isTOMLComment(s[end+2:]) {
+			return "", fmt.Errorf("invalid literal string")
+		}
+
+		return s[1 : end+1], nil
+	}
+
+	if !strings.HasPrefix(s, `"`) {
+		return "", fmt.Errorf("expected a quoted string")
+	}
+
+	// Find the closing quote, skipping escaped characters
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			if !isTOMLComment(s[i+1:]) {
+				return "", fmt.Errorf("unexpected text after string")
+			}
+
+			return strconv.Unquote(s[:i+1])
+		}
+	}
+
+	return "", fmt.Errorf("unterminated string")
+}
+
+// isTOMLComment reports whether s is empty or a comment, ignoring whitespace
+func isTOMLComment(s string) bool {
+	s = strings.TrimSpace(s)
+	return s == "" || strings.HasPrefix(s, "#")
+}
+
+// LoadProfile reads a profile from the config file at path, DefaultConfigPath when path is empty
+func LoadProfile(path, name string) (*Profile, error) {
+	if path == "" {
+		var err error
+		if path, err = DefaultConfigPath(); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles, err := ParseConfig(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("no profile %q in %s", name, path)
+	}
+
+	return &profile, nil
+}
+
+// NewClientFromProfile creates a Client configured by a profile of the config file.
+// The ARVANCLOUD_URL, ARVANCLOUD_API_VERSION and ARVANCLOUD_CA environment vars
+// take precedence over the settings of the profile unless they are empty.
+func NewClientFromProfile(name string) (Client, error) {
+	profile, err := LoadProfile("", name)
+	if err != nil {
+		return Client{}, err
+	}
+
+	return NewClientWithProfile(*profile), nil
+}
+
+// NewClientWithProfile creates a Client configured by profile, see NewClientFromProfile
+func NewClientWithProfile(profile Profile) Client {
+	client := NewClient(profile.APIKey)
+
+	if os.Getenv(APIHostVar) == "" && profile.BaseURL != "" {
+		client.SetBaseURL(profile.BaseURL)
+	}
+
+	if os.Getenv(APIVersionVar) == "" && profile.APIVersion != "" {
+		client.SetAPIVersion(profile.APIVersion)
+	}
+
+	if os.Getenv(APIHostCert) == "" && profile.CAPath != "" {
+		client.SetRootCertificate(expandHome(profile.CAPath))
+	}
+
+	return client
+}
+
+// NewClientFromEnv creates a Client configured by the environment: with the profile
+// named by ARVANCLOUD_PROFILE if set, otherwise with the ARVANCLOUD_TOKEN API key
+// if set, otherwise with the "default" profile of the config file
+func NewClientFromEnv() (Client, error) {
+	if profile := os.Getenv(ProfileVar); profile != "" {
+		return NewClientFromProfile(profile)
+	}
+
+	if token, ok := os.LookupEnv(APIEnvVar); ok {
+		return NewClient(token), nil
+	}
+
+	client, err := NewClientFromProfile(DefaultProfile)
+	if os.IsNotExist(err) {
+		path, _ := DefaultConfigPath()
+		return Client{}, fmt.Errorf("set %s or add a %q profile to %s", APIEnvVar, DefaultProfile, path)
+	}
+
+	return client, err
+}
+
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(home, path[2:])
+}
@@ -0,0 +1,117 @@
+package sdk
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseConfig(t *testing.T) {
+	config := `# Arvancloud accounts
+[default]
+api_key = "Apikey 1234" # personal
+
+[ "staging" ]
+api_key = 'Apikey C:\raw'
+base_url = "https://napi.staging.example.com"
+api_version = "cdn/4.0"
+ca_path = "~/certs/\"staging\".pem"
+`
+
+	profiles, err := ParseConfig(strings.NewReader(config))
+	if err != nil {
+		t.Fatalf("Error parsing config: %s", err)
+	}
+
+	expected := map[string]Profile{
+		"default": {Name: "default", APIKey: "Apikey 1234"},
+		"staging": {
+			Name:       "staging",
+			APIKey:     `Apikey C:\raw`,
+			BaseURL:    "https://napi.staging.example.com",
+			APIVersion: "cdn/4.0",
+			CAPath:     `~/certs/"staging".pem`,
+		},
+	}
+	if !cmp.Equal(profiles, expected) {
+		t.Error(cmp.Diff(profiles, expected))
+	}
+}
+
+func TestParseConfig_errors(t *testing.T) {
+	for _, tc := range []struct {
+		config, expected string
+	}{
+		{"api_key = \"x\"\n", "line 1: key outside of a profile table"},
+		{"[a]\napikey = \"x\"\n", `line 2: unknown key "apikey"`},
+		{"[a]\napi_key = x\n", "line 2: api_key: expected a quoted string"},
+		{"[a]\napi_key = \"x\" y\n", "line 2: api_key: unexpected text after string"},
+		{"[a]\n[b\n", "line 2: invalid table header"},
+		{"[a]\n\n[a]\n", `line 3: profile "a" defined twice`},
+	} {
+		_, err := ParseConfig(strings.NewReader(tc.config))
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("%q: expected error %q, got %v", tc.config, tc.expected, err)
+		}
+	}
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	config := filepath.Join(t.TempDir(), "config.toml")
+	if err := ioutil.WriteFile(config, []byte(`
+[default]
+api_key = "Apikey default"
+
+[staging]
+api_key = "Apikey staging"
+base_url = "http://api.staging.example.com"
+api_version = "cdn/5.0"
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(ConfigPathVar, config)
+	t.Setenv(ProfileVar, "staging")
+	t.Setenv(APIEnvVar, "Apikey env")
+
+	// t.Setenv restores these after the test, unset them meanwhile
+	for _, name := range []string{APIHostVar, APIVersionVar, APIHostCert} {
+		t.Setenv(name, "")
+		os.Unsetenv(name)
+	}
+
+	for _, tc := range []struct {
+		profile, token, expectedKey, expectedHost string
+	}{
+		{"staging", "Apikey env", "Apikey staging", "http://api.staging.example.com/cdn/5.0"},
+		{"", "Apikey env", "Apikey env", "https://napi.arvancloud.com/cdn/4.0"},
+		{"", "", "Apikey default", "https://napi.arvancloud.com/cdn/4.0"},
+	} {
+		os.Setenv(ProfileVar, tc.profile)
+		if tc.token == "" {
+			os.Unsetenv(APIEnvVar)
+		}
+
+		client, err := NewClientFromEnv()
+		if err != nil {
+			t.Fatalf("Error creating client: %s", err)
+		}
+
+		if key := client.resty.Header.Get("Authorization"); key != tc.expectedKey {
+			t.Errorf("expected API key %q, got %q", tc.expectedKey, key)
+		}
+
+		if client.resty.HostURL != tc.expectedHost {
+			t.Errorf("expected host %q, got %q", tc.expectedHost, client.resty.HostURL)
+		}
+	}
+
+	os.Setenv(ProfileVar, "missing")
+	if _, err := NewClientFromEnv(); err == nil {
+		t.Error("expected error for a missing profile")
+	}
+}
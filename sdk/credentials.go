@@ -0,0 +1,271 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ErrNoCredentials is returned by CredentialsProviders that have no credentials to
+// provide, e.g. EnvCredentials when ARVANCLOUD_TOKEN is not set. ChainCredentials
+// moves on to its next provider on this error.
+var ErrNoCredentials = errors.New("no credentials")
+
+// Credentials authenticate requests to the Arvancloud API
+type Credentials struct {
+	APIKey string
+	// Expires is when the credentials must be retrieved again, they do not expire when zero
+	Expires time.Time
+}
+
+// CredentialsProvider provides the Credentials of a Client, which retrieves them on every
+// request; wrap slow providers with NewCachedCredentials
+type CredentialsProvider interface {
+	Retrieve(ctx context.Context) (Credentials, error)
+}
+
+// CredentialsInvalidator is implemented by CredentialsProviders caching credentials.
+// A Client invalidates its provider when the API rejects its credentials.
+type CredentialsInvalidator interface {
+	Invalidate()
+}
+
+// CredentialsProviderFunc adapts a function, e.g. reading a secrets manager, to a CredentialsProvider
+type CredentialsProviderFunc func(ctx context.Context) (Credentials, error)
+
+// Retrieve calls f(ctx)
+func (f CredentialsProviderFunc) Retrieve(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// StaticCredentials provides a fixed API key
+type StaticCredentials string
+
+// Retrieve returns the API key
+func (s StaticCredentials) Retrieve(context.Context) (Credentials, error) {
+	if s == "" {
+		return Credentials{}, ErrNoCredentials
+	}
+
+	return Credentials{APIKey: string(s)}, nil
+}
+
+// EnvCredentials provides the API key of the ARVANCLOUD_TOKEN environment var
+type EnvCredentials struct{}
+
+// Retrieve reads ARVANCLOUD_TOKEN
+func (EnvCredentials) Retrieve(context.Context) (Credentials, error) {
+	token := os.Getenv(APIEnvVar)
+	if token == "" {
+		return Credentials{}, ErrNoCredentials
+	}
+
+	return Credentials{APIKey: token}, nil
+}
+
+// FileCredentials provides the API key stored in a file, such as a mounted secret
+type FileCredentials struct {
+	Path string
+}
+
+// Retrieve reads the file, ignoring surrounding whitespace
+func (f FileCredentials) Retrieve(context.Context) (Credentials, error) {
+	b, err := ioutil.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		return Credentials{}, fmt.Errorf("%w: %s", ErrNoCredentials, err)
+	} else if err != nil {
+		return Credentials{}, err
+	}
+
+	key := strings.TrimSpace(string(b))
+	if key == "" {
+		return Credentials{}, fmt.Errorf("%w: %s is empty", ErrNoCredentials, f.Path)
+	}
+
+	return Credentials{APIKey: key}, nil
+}
+
+// ProfileCredentials provides the API key of a profile of the config file
+type ProfileCredentials struct {
+	// Path of the config file, DefaultConfigPath when empty
+	Path string
+	// Profile is the name of the profile, DefaultProfile when empty
+	Profile string
+}
+
+// Retrieve reads the profile from the config file
+func (p ProfileCredentials) Retrieve(context.Context) (Credentials, error) {
+	name := p.Profile
+	if name == "" {
+		name = DefaultProfile
+	}
+
+	profile, err := LoadProfile(p.Path, name)
+	if os.IsNotExist(err) {
+		return Credentials{}, fmt.Errorf("%w: %s", ErrNoCredentials, err)
+	} else if err != nil {
+		return Credentials{}, err
+	}
+
+	if profile.APIKey == "" {
+		return Credentials{}, fmt.Errorf("%w: profile %q has no api_key", ErrNoCredentials, name)
+	}
+
+	return Credentials{APIKey: profile.APIKey}, nil
+}
+
+// ChainCredentials provides the credentials of the first of its providers having any
+type ChainCredentials []CredentialsProvider
+
+// Retrieve tries each provider in order, skipping those returning ErrNoCredentials
+func (c ChainCredentials) Retrieve(ctx context.Context) (Credentials, error) {
+	for _, p := range c {
+		creds, err := p.Retrieve(ctx)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+
+		return creds, err
+	}
+
+	return Credentials{}, ErrNoCredentials
+}
+
+// Invalidate invalidates the providers of the chain caching credentials
+func (c ChainCredentials) Invalidate() {
+	for _, p := range c {
+		if i, ok := p.(CredentialsInvalidator); ok {
+			i.Invalidate()
+		}
+	}
+}
+
+// CachedCredentials caches the credentials of a provider until they expire,
+// or until they are older than its TTL. It is safe for concurrent use.
+type CachedCredentials struct {
+	provider CredentialsProvider
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu        sync.Mutex
+	creds     Credentials
+	refreshAt time.Time
+}
+
+// NewCachedCredentials caches the credentials of provider for at most ttl,
+// until they expire when ttl is zero
+func NewCachedCredentials(provider CredentialsProvider, ttl time.Duration) *CachedCredentials {
+	return &CachedCredentials{provider: provider, ttl: ttl, now: time.Now}
+}
+
+// Retrieve returns the cached credentials, retrieving them from the provider when
+// there are none or they are due for a refresh
+func (c *CachedCredentials) Retrieve(ctx context.Context) (Credentials, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if c.creds.APIKey != "" && (c.refreshAt.IsZero() || now.Before(c.refreshAt)) {
+		return c.creds, nil
+	}
+
+	creds, err := c.provider.Retrieve(ctx)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	c.creds = creds
+	c.refreshAt = creds.Expires
+	if c.ttl > 0 && (c.refreshAt.IsZero() || now.Add(c.ttl).Before(c.refreshAt)) {
+		c.refreshAt = now.Add(c.ttl)
+	}
+
+	return creds, nil
+}
+
+// Invalidate drops the cached credentials, the next Retrieve refreshes them
+func (c *CachedCredentials) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.creds = Credentials{}
+
+	if i, ok := c.provider.(CredentialsInvalidator); ok {
+		i.Invalidate()
+	}
+}
+
+// credentialsState holds the CredentialsProvider of a Client. It is shared by
+// copies of the Client, so it lives behind a pointer and a mutex.
+type credentialsState struct {
+	mu       sync.RWMutex
+	provider CredentialsProvider
+}
+
+func (s *credentialsState) get() CredentialsProvider {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.provider
+}
+
+// authorize sets the Authorization header of each request from the CredentialsProvider, if any
+func (s *credentialsState) authorize(_ *resty.Client, req *resty.Request) error {
+	provider := s.get()
+	if provider == nil {
+		return nil
+	}
+
+	creds, err := provider.Retrieve(req.Context())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve credentials: %w", err)
+	}
+
+	req.SetHeader("Authorization", creds.APIKey)
+	return nil
+}
+
+// rotatedRetryCondition retries requests rejected as unauthorized when refreshing the
+// credentials yields a different API key, i.e. when the key was rotated
+func (s *credentialsState) rotatedRetryCondition(r *resty.Response, _ error) bool {
+	provider := s.get()
+	if provider == nil || r.StatusCode() != http.StatusUnauthorized {
+		return false
+	}
+
+	i, ok := provider.(CredentialsInvalidator)
+	if !ok {
+		return false
+	}
+	i.Invalidate()
+
+	creds, err := provider.Retrieve(r.Request.Context())
+	return err == nil && creds.APIKey != r.Request.Header.Get("Authorization")
+}
+
+// SetCredentialsProvider makes the Client authenticate every request with the
+// credentials of provider, instead of the API key it was created with.
+// A nil provider restores the API key set by SetAuthHeader.
+func (c *Client) SetCredentialsProvider(provider CredentialsProvider) *Client {
+	c.credentials.mu.Lock()
+	c.credentials.provider = provider
+	c.credentials.mu.Unlock()
+
+	return c
+}
+
+// NewClientWithCredentials creates a Client authenticating with the credentials of provider
+func NewClientWithCredentials(provider CredentialsProvider) Client {
+	client := NewClient("")
+	client.SetCredentialsProvider(provider)
+
+	return client
+}
@@ -0,0 +1,132 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestChainCredentials(t *testing.T) {
+	t.Setenv(APIEnvVar, "")
+
+	chain := ChainCredentials{
+		EnvCredentials{},
+		FileCredentials{Path: filepath.Join(t.TempDir(), "missing")},
+		ProfileCredentials{Path: filepath.Join(t.TempDir(), "missing.toml")},
+		StaticCredentials("Apikey static"),
+	}
+
+	creds, err := chain.Retrieve(context.Background())
+	if err != nil || creds.APIKey != "Apikey static" {
+		t.Errorf("expected the static credentials, got %#v (%v)", creds, err)
+	}
+
+	t.Setenv(APIEnvVar, "Apikey env")
+
+	creds, err = chain.Retrieve(context.Background())
+	if err != nil || creds.APIKey != "Apikey env" {
+		t.Errorf("expected the env credentials, got %#v (%v)", creds, err)
+	}
+
+	if _, err := (ChainCredentials{EnvCredentials{}}).Retrieve(context.Background()); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	if _, err := (ChainCredentials{StaticCredentials("")}).Retrieve(context.Background()); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("expected ErrNoCredentials, got %v", err)
+	}
+}
+
+func TestCachedCredentials(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	calls := 0
+
+	cached := NewCachedCredentials(CredentialsProviderFunc(func(context.Context) (Credentials, error) {
+		calls++
+		return Credentials{APIKey: "Apikey", Expires: now.Add(time.Hour)}, nil
+	}), 10*time.Minute)
+	cached.now = func() time.Time { return now }
+
+	for _, step := range []struct {
+		advance       time.Duration
+		invalidate    bool
+		expectedCalls int
+	}{
+		{0, false, 1},
+		{5 * time.Minute, false, 1},
+		{5 * time.Minute, false, 2},
+		{time.Minute, true, 3},
+	} {
+		now = now.Add(step.advance)
+		if step.invalidate {
+			cached.Invalidate()
+		}
+
+		if _, err := cached.Retrieve(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+
+		if calls != step.expectedCalls {
+			t.Errorf("after %s: expected %d calls, got %d", step.advance, step.expectedCalls, calls)
+		}
+	}
+}
+
+func TestClient_SetCredentialsProvider_rotation(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		key      = "Apikey old"
+		accepted []string
+	)
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Header.Get("Authorization") != key {
+			writeJSON(w, http.StatusUnauthorized, `{"errors":[{"reason":"Unauthenticated."}]}`)
+			return
+		}
+
+		accepted = append(accepted, r.Header.Get("Authorization"))
+		writeJSON(w, http.StatusOK, `{"data":[],"meta":{"current_page":1,"last_page":1}}`)
+	})
+	client.SetRetryWaitTime(time.Millisecond)
+	client.SetRetryMaxWaitTime(time.Millisecond)
+
+	client.SetCredentialsProvider(NewCachedCredentials(CredentialsProviderFunc(func(context.Context) (Credentials, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return Credentials{APIKey: key}, nil
+	}), time.Hour))
+
+	if _, err := client.ListDomains(context.Background(), nil); err != nil {
+		t.Fatalf("Error listing domains: %s", err)
+	}
+
+	// Rotate the key, the cached one is rejected once and then refreshed
+	mu.Lock()
+	key = "Apikey new"
+	mu.Unlock()
+
+	if _, err := client.ListDomains(context.Background(), nil); err != nil {
+		t.Fatalf("Error listing domains after rotation: %s", err)
+	}
+
+	if len(accepted) != 2 || accepted[1] != "Apikey new" {
+		t.Errorf("unexpected accepted keys %v", accepted)
+	}
+
+	// A key that is still rejected after refreshing is not retried
+	client.SetCredentialsProvider(StaticCredentials("Apikey wrong"))
+
+	_, err := client.ListDomains(context.Background(), nil)
+	if e := (&Error{}); !errors.As(err, &e) || e.Code != http.StatusUnauthorized {
+		t.Errorf("expected a 401 error, got %v", err)
+	}
+}
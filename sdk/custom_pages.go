@@ -0,0 +1,131 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+)
+
+// MaxCustomPageSize is the largest HTML content, in bytes, accepted for a CustomPage
+const MaxCustomPageSize = 256 * 1024
+
+// CustomPageType is the situation a CustomPage is served in
+type CustomPageType string
+
+// CustomPageType enums
+const (
+	CustomPageServerError    CustomPageType = "5xx"
+	CustomPageFirewallBlock  CustomPageType = "firewall"
+	CustomPageRateLimit      CustomPageType = "rate_limit"
+	CustomPageDDoSChallenge  CustomPageType = "ddos_challenge"
+	CustomPageWAFBlock       CustomPageType = "waf"
+	CustomPageDomainInactive CustomPageType = "inactive"
+)
+
+// CustomPage is HTML served by the CDN in place of the default page of its Type
+type CustomPage struct {
+	Type      CustomPageType `json:"type"`
+	Content   string         `json:"content"`
+	Enabled   bool           `json:"enabled"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// CustomPageUpdateOptions fields are those accepted by UpdateCustomPage.
+// The content of the page is left unchanged when Content is empty.
+type CustomPageUpdateOptions struct {
+	Content string `json:"content,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
+
+// ListCustomPages lists the CustomPages of a Domain
+func (c *Client) ListCustomPages(ctx context.Context, domain string) ([]CustomPage, error) {
+	e, err := c.CustomPages.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := []CustomPage{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(&pages)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return pages, nil
+}
+
+// GetCustomPage gets the CustomPage of a Domain of the given type
+func (c *Client) GetCustomPage(ctx context.Context, domain string, pageType CustomPageType) (*CustomPage, error) {
+	e, err := c.CustomPages.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, pageType)
+
+	page := &CustomPage{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(page)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// UpdateCustomPage updates the content or state of the CustomPage of a Domain of the given type.
+// Content larger than MaxCustomPageSize is rejected without calling the API.
+func (c *Client) UpdateCustomPage(ctx context.Context, domain string, pageType CustomPageType, updateOpts CustomPageUpdateOptions) (*CustomPage, error) {
+	if len(updateOpts.Content) > MaxCustomPageSize {
+		return nil, NewError(fmt.Sprintf("Custom page content is %d bytes, larger than the maximum of %d", len(updateOpts.Content), MaxCustomPageSize))
+	}
+
+	e, err := c.CustomPages.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, pageType)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	page := &CustomPage{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(page)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// UploadCustomPage sets the HTML content of the CustomPage of a Domain of the given type
+// from content, e.g. an opened file. The page's enabled state is not changed.
+// Empty content is rejected without calling the API.
+func (c *Client) UploadCustomPage(ctx context.Context, domain string, pageType CustomPageType, content io.Reader) (*CustomPage, error) {
+	// Read one byte past the limit so oversized content is detected without reading it all
+	html, err := ioutil.ReadAll(io.LimitReader(content, MaxCustomPageSize+1))
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	if len(html) > MaxCustomPageSize {
+		return nil, NewError(fmt.Sprintf("Custom page content is larger than the maximum of %d bytes", MaxCustomPageSize))
+	}
+
+	if len(html) == 0 {
+		return nil, NewError("Custom page content is empty")
+	}
+
+	return c.UpdateCustomPage(ctx, domain, pageType, CustomPageUpdateOptions{Content: string(html)})
+}
+
+// EnableCustomPage serves the CustomPage of a Domain of the given type instead of the default page
+func (c *Client) EnableCustomPage(ctx context.Context, domain string, pageType CustomPageType) (*CustomPage, error) {
+	enabled := true
+	return c.UpdateCustomPage(ctx, domain, pageType, CustomPageUpdateOptions{Enabled: &enabled})
+}
+
+// DisableCustomPage serves the default page of the given type for a Domain again
+func (c *Client) DisableCustomPage(ctx context.Context, domain string, pageType CustomPageType) (*CustomPage, error) {
+	enabled := false
+	return c.UpdateCustomPage(ctx, domain, pageType, CustomPageUpdateOptions{Enabled: &enabled})
+}
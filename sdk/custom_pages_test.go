@@ -0,0 +1,54 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestUploadCustomPage_tooLarge(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	content := strings.NewReader(strings.Repeat("a", MaxCustomPageSize+1))
+	_, err := client.UploadCustomPage(context.Background(), "example.com", CustomPageServerError, content)
+	if err == nil {
+		t.Fatal("expected error uploading oversized custom page")
+	}
+
+	if apiErr, ok := err.(*Error); !ok || apiErr.Code != ErrorFromString {
+		t.Errorf("unexpected error %#v", err)
+	}
+}
+
+func TestUploadCustomPage_empty(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	_, err := client.UploadCustomPage(context.Background(), "example.com", CustomPageServerError, strings.NewReader(""))
+	if apiErr, ok := err.(*Error); !ok || apiErr.Code != ErrorFromString {
+		t.Errorf("expected an error uploading an empty custom page, got %#v", err)
+	}
+}
+
+func TestUploadCustomPage(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/cdn/4.0/domains/example.com/custom-pages/5xx" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		writeJSON(w, http.StatusOK, `{"data":{"type":"5xx","content":"<h1>down</h1>","enabled":true}}`)
+	})
+
+	page, err := client.UploadCustomPage(context.Background(), "example.com", CustomPageServerError, strings.NewReader("<h1>down</h1>"))
+	if err != nil {
+		t.Fatalf("Error uploading custom page: %s", err)
+	}
+
+	if page.Type != CustomPageServerError || page.Content != "<h1>down</h1>" {
+		t.Errorf("unexpected page %#v", page)
+	}
+}
@@ -0,0 +1,231 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DDoSProtectionMode is how aggressively a Domain is protected from DDoS attacks
+type DDoSProtectionMode string
+
+// DDoSProtectionMode enums
+const (
+	DDoSProtectionOff         DDoSProtectionMode = "off"
+	DDoSProtectionBasic       DDoSProtectionMode = "basic"
+	DDoSProtectionMedium      DDoSProtectionMode = "medium"
+	DDoSProtectionUnderAttack DDoSProtectionMode = "under_attack"
+)
+
+// DDoSChallengeType is the challenge presented to visitors suspected of being part of an attack
+type DDoSChallengeType string
+
+// DDoSChallengeType enums
+const (
+	DDoSChallengeCookie     DDoSChallengeType = "cookie"
+	DDoSChallengeJavaScript DDoSChallengeType = "javascript"
+	DDoSChallengeCaptcha    DDoSChallengeType = "captcha"
+)
+
+// DDoSSettings represents the DDoS protection settings of a Domain.
+// Editable when Domain.Features.EditableDdosRules is set.
+type DDoSSettings struct {
+	Mode          DDoSProtectionMode `json:"protection_mode"`
+	ChallengeType DDoSChallengeType  `json:"challenge_type"`
+	// ChallengeTTL is how long, in seconds, a passed challenge is remembered
+	ChallengeTTL int `json:"challenge_ttl"`
+}
+
+// DDoSSettingsUpdateOptions fields are those accepted by UpdateDDoSSettings
+type DDoSSettingsUpdateOptions struct {
+	Mode          DDoSProtectionMode `json:"protection_mode,omitempty"`
+	ChallengeType DDoSChallengeType  `json:"challenge_type,omitempty"`
+	ChallengeTTL  int                `json:"challenge_ttl,omitempty"`
+}
+
+// DDoSRule applies a challenge to requests matching a URL pattern
+type DDoSRule struct {
+	ID            string            `json:"id"`
+	URLPattern    string            `json:"url_pattern"`
+	ChallengeType DDoSChallengeType `json:"challenge_type"`
+	ChallengeTTL  int               `json:"challenge_ttl"`
+	Enabled       bool              `json:"enabled"`
+	Description   string            `json:"description"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+// DDoSRuleCreateOptions fields are those accepted by CreateDDoSRule
+type DDoSRuleCreateOptions struct {
+	URLPattern    string            `json:"url_pattern"`
+	ChallengeType DDoSChallengeType `json:"challenge_type"`
+	ChallengeTTL  int               `json:"challenge_ttl,omitempty"`
+	Enabled       *bool             `json:"enabled,omitempty"`
+	Description   string            `json:"description,omitempty"`
+}
+
+// DDoSRuleUpdateOptions fields are those accepted by UpdateDDoSRule
+type DDoSRuleUpdateOptions struct {
+	URLPattern    string            `json:"url_pattern,omitempty"`
+	ChallengeType DDoSChallengeType `json:"challenge_type,omitempty"`
+	ChallengeTTL  int               `json:"challenge_ttl,omitempty"`
+	Enabled       *bool             `json:"enabled,omitempty"`
+	Description   string            `json:"description,omitempty"`
+}
+
+// GetUpdateOptions converts a DDoSRule to DDoSRuleUpdateOptions for use in UpdateDDoSRule
+func (r DDoSRule) GetUpdateOptions() DDoSRuleUpdateOptions {
+	enabled := r.Enabled
+
+	return DDoSRuleUpdateOptions{
+		URLPattern:    r.URLPattern,
+		ChallengeType: r.ChallengeType,
+		ChallengeTTL:  r.ChallengeTTL,
+		Enabled:       &enabled,
+		Description:   r.Description,
+	}
+}
+
+// DDoSRulesPagedResponse represents a paginated DDoSRule API response
+type DDoSRulesPagedResponse struct {
+	*PageOptions
+	Data []DDoSRule `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for DDoSRules of a Domain
+func (DDoSRulesPagedResponse) endpointWithID(c *Client, domain string) string {
+	endpoint, err := c.DDoSRules.endpointWithParams(domain)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends DDoSRules when processing paginated DDoSRule responses
+func (resp *DDoSRulesPagedResponse) appendData(r *DDoSRulesPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// GetDDoSSettings gets the DDoS protection settings of a Domain
+func (c *Client) GetDDoSSettings(ctx context.Context, domain string) (*DDoSSettings, error) {
+	e, err := c.DDoSSettings.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &DDoSSettings{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(settings)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// UpdateDDoSSettings updates the DDoS protection settings of a Domain.
+// Fields left empty in updateOpts are not changed.
+func (c *Client) UpdateDDoSSettings(ctx context.Context, domain string, updateOpts DDoSSettingsUpdateOptions) (*DDoSSettings, error) {
+	e, err := c.DDoSSettings.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	settings := &DDoSSettings{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(settings)).SetBody(string(bodyData)).Patch(e)); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// SetDDoSChallengeType switches the challenge presented to the visitors of a Domain,
+// e.g. to DDoSChallengeCaptcha during an attack
+func (c *Client) SetDDoSChallengeType(ctx context.Context, domain string, challengeType DDoSChallengeType) (*DDoSSettings, error) {
+	return c.UpdateDDoSSettings(ctx, domain, DDoSSettingsUpdateOptions{ChallengeType: challengeType})
+}
+
+// ListDDoSRules lists the custom DDoSRules of a Domain
+func (c *Client) ListDDoSRules(ctx context.Context, domain string, opts *ListOptions) ([]DDoSRule, error) {
+	response := DDoSRulesPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, domain, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetDDoSRule gets a custom DDoSRule of a Domain
+func (c *Client) GetDDoSRule(ctx context.Context, domain, ruleID string) (*DDoSRule, error) {
+	e, err := c.DDoSRules.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	rule := &DDoSRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// CreateDDoSRule creates a custom DDoSRule on a Domain
+func (c *Client) CreateDDoSRule(ctx context.Context, domain string, createOpts DDoSRuleCreateOptions) (*DDoSRule, error) {
+	e, err := c.DDoSRules.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	rule := &DDoSRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// UpdateDDoSRule updates a custom DDoSRule of a Domain
+func (c *Client) UpdateDDoSRule(ctx context.Context, domain, ruleID string, updateOpts DDoSRuleUpdateOptions) (*DDoSRule, error) {
+	e, err := c.DDoSRules.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	rule := &DDoSRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// DeleteDDoSRule deletes a custom DDoSRule of a Domain
+func (c *Client) DeleteDDoSRule(ctx context.Context, domain, ruleID string) error {
+	e, err := c.DDoSRules.endpointWithParams(domain)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
@@ -0,0 +1,125 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDDoSSettings(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		if r.Method == http.MethodGet {
+			writeJSON(w, http.StatusOK, `{"data":{"protection_mode":"basic","challenge_type":"cookie","challenge_ttl":600}}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"data":{"protection_mode":"basic","challenge_type":"captcha","challenge_ttl":600}}`)
+	})
+
+	settings, err := client.GetDDoSSettings(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("Error getting DDoS settings: %s", err)
+	}
+
+	expected := &DDoSSettings{Mode: DDoSProtectionBasic, ChallengeType: DDoSChallengeCookie, ChallengeTTL: 600}
+	if !cmp.Equal(settings, expected) {
+		t.Error(cmp.Diff(settings, expected))
+	}
+
+	settings, err = client.SetDDoSChallengeType(context.Background(), "example.com", DDoSChallengeCaptcha)
+	if err != nil {
+		t.Fatalf("Error updating DDoS settings: %s", err)
+	}
+
+	if settings.ChallengeType != DDoSChallengeCaptcha {
+		t.Errorf("expected the captcha challenge, got %s", settings.ChallengeType)
+	}
+
+	expectedRequests := []string{
+		"GET /cdn/4.0/domains/example.com/ddos ",
+		`PATCH /cdn/4.0/domains/example.com/ddos {"challenge_type":"captcha"}`,
+	}
+	if !cmp.Equal(requests, expectedRequests) {
+		t.Error(cmp.Diff(requests, expectedRequests))
+	}
+}
+
+func TestDDoSRules(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Path == "/cdn/4.0/domains/example.com/ddos/rules" {
+				writeJSON(w, http.StatusOK, `{"data":[{"id":"r1","url_pattern":"/login*","challenge_type":"captcha","enabled":true}]}`)
+				return
+			}
+			writeJSON(w, http.StatusOK, `{"data":{"id":"r1","url_pattern":"/login*","challenge_type":"captcha","enabled":true}}`)
+		case http.MethodDelete:
+			writeJSON(w, http.StatusOK, `{"message":"ok"}`)
+		default:
+			writeJSON(w, http.StatusOK, `{"data":{"id":"r1","url_pattern":"/login*","challenge_type":"captcha","enabled":false}}`)
+		}
+	})
+
+	ctx := context.Background()
+	enabled := true
+
+	rule, err := client.CreateDDoSRule(ctx, "example.com", DDoSRuleCreateOptions{
+		URLPattern:    "/login*",
+		ChallengeType: DDoSChallengeCaptcha,
+		Enabled:       &enabled,
+	})
+	if err != nil {
+		t.Fatalf("Error creating DDoS rule: %s", err)
+	}
+
+	rules, err := client.ListDDoSRules(ctx, "example.com", nil)
+	if err != nil {
+		t.Fatalf("Error listing DDoS rules: %s", err)
+	}
+
+	if len(rules) != 1 || rules[0].ID != rule.ID {
+		t.Errorf("unexpected rules %#v", rules)
+	}
+
+	if _, err := client.GetDDoSRule(ctx, "example.com", rule.ID); err != nil {
+		t.Fatalf("Error getting DDoS rule: %s", err)
+	}
+
+	disabled := false
+	rule, err = client.UpdateDDoSRule(ctx, "example.com", rule.ID, DDoSRuleUpdateOptions{Enabled: &disabled})
+	if err != nil {
+		t.Fatalf("Error updating DDoS rule: %s", err)
+	}
+
+	if rule.Enabled {
+		t.Error("expected the rule to be disabled")
+	}
+
+	if err := client.DeleteDDoSRule(ctx, "example.com", rule.ID); err != nil {
+		t.Fatalf("Error deleting DDoS rule: %s", err)
+	}
+
+	expected := []string{
+		`POST /cdn/4.0/domains/example.com/ddos/rules {"url_pattern":"/login*","challenge_type":"captcha","enabled":true}`,
+		"GET /cdn/4.0/domains/example.com/ddos/rules ",
+		"GET /cdn/4.0/domains/example.com/ddos/rules/r1 ",
+		`PUT /cdn/4.0/domains/example.com/ddos/rules/r1 {"enabled":false}`,
+		"DELETE /cdn/4.0/domains/example.com/ddos/rules/r1 ",
+	}
+	if !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+}
@@ -0,0 +1,100 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// DNSSECStatus is the state of DNSSEC signing for a Domain
+type DNSSECStatus string
+
+// DNSSECStatus enums
+const (
+	DNSSECStatusDisabled DNSSECStatus = "disabled"
+	// DNSSECStatusPending means the zone is signed but the DS record has not been seen at the registrar yet
+	DNSSECStatusPending DNSSECStatus = "pending"
+	DNSSECStatusActive  DNSSECStatus = "active"
+)
+
+// DSRecord is a Delegation Signer record to submit to the registrar of a Domain
+type DSRecord struct {
+	KeyTag     int    `json:"key_tag"`
+	Algorithm  int    `json:"algorithm"`
+	DigestType int    `json:"digest_type"`
+	Digest     string `json:"digest"`
+}
+
+// String formats the record as its RDATA, "<key tag> <algorithm> <digest type> <digest>",
+// the form most registrars accept
+func (r DSRecord) String() string {
+	return fmt.Sprintf("%d %d %d %s", r.KeyTag, r.Algorithm, r.DigestType, strings.ToUpper(r.Digest))
+}
+
+// DNSSEC represents the DNSSEC state of a Domain served by the Arvancloud DNS (Domain.DNSCloud)
+type DNSSEC struct {
+	Enabled   bool         `json:"enabled"`
+	Status    DNSSECStatus `json:"status"`
+	DSRecords []DSRecord   `json:"ds_records"`
+}
+
+// ZoneRecords formats the DS records as zone file lines for domain, for registrars
+// accepting full records, e.g. "example.com. 3600 IN DS 2371 13 2 1F98...".
+func (d DNSSEC) ZoneRecords(domain string, ttl int) []string {
+	owner := strings.TrimSuffix(domain, ".") + "."
+
+	records := make([]string, 0, len(d.DSRecords))
+	for _, r := range d.DSRecords {
+		records = append(records, fmt.Sprintf("%s %d IN DS %s", owner, ttl, r))
+	}
+
+	return records
+}
+
+// GetDNSSEC gets the DNSSEC state and DS records of a Domain
+func (c *Client) GetDNSSEC(ctx context.Context, domain string) (*DNSSEC, error) {
+	e, err := c.DNSSEC.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	dnssec := &DNSSEC{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(dnssec)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return dnssec, nil
+}
+
+// EnableDNSSEC signs the zone of a Domain and returns the DS records to submit to its registrar
+func (c *Client) EnableDNSSEC(ctx context.Context, domain string) (*DNSSEC, error) {
+	return c.setDNSSEC(ctx, domain, true)
+}
+
+// DisableDNSSEC stops signing the zone of a Domain. Remove the DS records at the
+// registrar first, or resolvers will fail to validate the zone.
+func (c *Client) DisableDNSSEC(ctx context.Context, domain string) (*DNSSEC, error) {
+	return c.setDNSSEC(ctx, domain, false)
+}
+
+func (c *Client) setDNSSEC(ctx context.Context, domain string, enabled bool) (*DNSSEC, error) {
+	e, err := c.DNSSEC.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(struct {
+		Enabled bool `json:"enabled"`
+	}{enabled})
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	dnssec := &DNSSEC{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(dnssec)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return dnssec, nil
+}
@@ -0,0 +1,20 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDNSSEC_ZoneRecords(t *testing.T) {
+	dnssec := DNSSEC{DSRecords: []DSRecord{
+		{KeyTag: 2371, Algorithm: 13, DigestType: 2, Digest: "1f987cc6583e92df0890718c42"},
+	}}
+
+	records := dnssec.ZoneRecords("example.com", 3600)
+
+	expected := []string{"example.com. 3600 IN DS 2371 13 2 1F987CC6583E92DF0890718C42"}
+	if !cmp.Equal(records, expected) {
+		t.Error(cmp.Diff(records, expected))
+	}
+}
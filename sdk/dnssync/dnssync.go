@@ -0,0 +1,574 @@
+// Package dnssync reconciles the DNS records of a Domain with a desired set of
+// records: it computes a Plan of creates, updates and deletes and applies it.
+//
+// Only records owned by the Syncer are ever changed. Ownership is recorded in a
+// TXT marker record per owner and owned name, "_dnssync.<name>" ("_dnssync" for
+// the apex), listing the owner and the record types it manages at that name, e.g.
+// "heritage=dnssync owner=ops types=a,txt". Several owners may manage different
+// record types at the same name. Records of other owners or of no owner are left
+// alone.
+//
+// Records are created before others are deleted so names keep resolving, also
+// when a CNAME replaces records of other types at its name: the CNAME is created
+// first and the records it replaces are deleted right after.
+package dnssync
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/S4eedb/arvancloud-go/sdk"
+	"github.com/S4eedb/arvancloud-go/sdk/zonefile"
+)
+
+const (
+	// markerPrefix is the label prepended to a name to form the name of its ownership marker
+	markerPrefix = "_dnssync"
+	// heritage identifies marker records written by this package
+	heritage = "heritage=dnssync"
+)
+
+// Client is the subset of *sdk.Client used by a Syncer
+type Client interface {
+	ListDomainRecords(ctx context.Context, domain string, opts *sdk.ListOptions) ([]sdk.DomainRecord, error)
+	CreateDomainRecord(ctx context.Context, domain string, createOpts sdk.DomainRecordCreateOptions) (*sdk.DomainRecord, error)
+	UpdateDomainRecord(ctx context.Context, domain, recordID string, updateOpts sdk.DomainRecordUpdateOptions) (*sdk.DomainRecord, error)
+	DeleteDomainRecord(ctx context.Context, domain, recordID string) error
+}
+
+// Syncer reconciles Domains with desired records on behalf of an owner
+type Syncer struct {
+	client Client
+	owner  string
+
+	// AdoptExisting takes ownership of unowned records at the names and types of
+	// desired records instead of failing to plan. Use it when first moving a zone
+	// under management.
+	AdoptExisting bool
+}
+
+// New creates a Syncer managing records on behalf of owner. owner must not contain whitespace.
+func New(client Client, owner string) *Syncer {
+	return &Syncer{client: client, owner: owner}
+}
+
+// Action is the kind of a Change
+type Action string
+
+// Action enums
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Change is a single API call of a Plan. Record is the desired record for creates
+// and updates, Current the existing record for updates and deletes.
+type Change struct {
+	Action  Action
+	Record  sdk.DomainRecord
+	Current sdk.DomainRecord
+	// Marker is set for changes to ownership marker records
+	Marker bool
+}
+
+// Plan is the ordered list of Changes reconciling a Domain with desired records
+type Plan struct {
+	Domain  string
+	Changes []Change
+}
+
+// Empty reports whether the Domain already matches the desired records
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String formats the plan as a human readable diff, one block per change with
+// the removed ("-") and added ("+") zone file lines
+func (p *Plan) String() string {
+	if p.Empty() {
+		return fmt.Sprintf("%s: no changes\n", p.Domain)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %d changes\n", p.Domain, len(p.Changes))
+
+	for _, c := range p.Changes {
+		r := c.subject()
+
+		kind := ""
+		if c.Marker {
+			kind = " (ownership marker)"
+		}
+		fmt.Fprintf(&b, "%s %s %s%s\n", c.Action, r.Name, strings.ToUpper(string(r.Type)), kind)
+
+		if c.Action != ActionCreate {
+			writeLines(&b, "-", c.Current)
+		}
+		if c.Action != ActionDelete {
+			writeLines(&b, "+", c.Record)
+		}
+	}
+
+	return b.String()
+}
+
+func writeLines(b *strings.Builder, prefix string, r sdk.DomainRecord) {
+	lines, err := zonefile.FormatRecord(r)
+	if err != nil {
+		lines = []string{fmt.Sprintf("%s %d IN %s %v", r.Name, r.TTL, strings.ToUpper(string(r.Type)), r.Value)}
+	}
+
+	for _, line := range lines {
+		fmt.Fprintf(b, "  %s %s\n", prefix, line)
+	}
+}
+
+// key identifies a record set, the records of one type at one name
+type key struct {
+	name       string
+	recordType sdk.DomainRecordType
+}
+
+// marker is a parsed ownership marker record
+type marker struct {
+	record sdk.DomainRecord
+	owner  string
+	types  map[sdk.DomainRecordType]bool
+}
+
+// Plan fetches the records of domain and computes the changes needed for the
+// records owned by the Syncer to match desired. Desired records with a zero TTL
+// match existing records of any TTL.
+func (s *Syncer) Plan(ctx context.Context, domain string, desired []sdk.DomainRecord) (*Plan, error) {
+	if s.owner == "" || strings.ContainsAny(s.owner, " \t") {
+		return nil, fmt.Errorf("dnssync: invalid owner %q", s.owner)
+	}
+
+	current, err := s.client.ListDomainRecords(ctx, domain, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// markers are those of the Syncer's owner by name, claims the owners
+	// of the record sets claimed by other markers
+	markers := map[string]*marker{}
+	claims := map[key]string{}
+	existing := map[key][]sdk.DomainRecord{}
+
+	for _, r := range current {
+		r = normalize(r)
+
+		if name, ok := markedName(r.Name); ok && r.Type == sdk.RecordTypeTXT {
+			if m := parseMarker(r); m != nil {
+				if m.owner == s.owner {
+					markers[name] = m
+				} else {
+					for t := range m.types {
+						claims[key{name, t}] = m.owner
+					}
+				}
+				continue
+			}
+		}
+
+		k := key{r.Name, r.Type}
+		existing[k] = append(existing[k], r)
+	}
+
+	wanted := map[key][]sdk.DomainRecord{}
+	for _, r := range desired {
+		r = normalize(r)
+
+		if _, ok := markedName(r.Name); ok {
+			return nil, fmt.Errorf("dnssync: %s is reserved for ownership markers", r.Name)
+		}
+
+		k := key{r.Name, r.Type}
+		wanted[k] = append(wanted[k], r)
+	}
+
+	owned := func(k key) bool {
+		m, ok := markers[k.name]
+		return ok && m.types[k.recordType]
+	}
+
+	for k := range wanted {
+		if owned(k) || len(existing[k]) == 0 {
+			continue
+		}
+
+		if owner, ok := claims[k]; ok {
+			return nil, fmt.Errorf("dnssync: %s %s is owned by %s", k.name, strings.ToUpper(string(k.recordType)), owner)
+		}
+
+		if !s.AdoptExisting {
+			return nil, fmt.Errorf("dnssync: %s %s exists and is not owned by %s", k.name, strings.ToUpper(string(k.recordType)), s.owner)
+		}
+	}
+
+	// Managed record sets are the owned ones plus those about to be owned
+	managed := map[key][]sdk.DomainRecord{}
+	for k, records := range existing {
+		if owned(k) || len(wanted[k]) > 0 {
+			managed[k] = records
+		}
+	}
+
+	plan := &Plan{Domain: domain}
+	var creates, updates, deletes []Change
+
+	for _, k := range sortedKeys(wanted, managed) {
+		c, u, d := diffRecordSet(wanted[k], managed[k])
+		creates, updates, deletes = append(creates, c...), append(updates, u...), append(deletes, d...)
+	}
+
+	if err := checkConflicts(creates, existing, managed); err != nil {
+		return nil, err
+	}
+
+	first, last := s.markerChanges(markers, wanted, managed)
+
+	plan.Changes = append(plan.Changes, first...)
+	plan.Changes = append(plan.Changes, creates...)
+	plan.Changes = append(plan.Changes, updates...)
+	plan.Changes = append(plan.Changes, orderDeletes(creates, deletes)...)
+	plan.Changes = append(plan.Changes, last...)
+
+	return plan, nil
+}
+
+// diffRecordSet compares the desired and current records of one record set.
+// Equal records are kept; a single changed record is updated in place so the
+// name never stops resolving.
+func diffRecordSet(desired, current []sdk.DomainRecord) (creates, updates, deletes []Change) {
+	var unmatched []sdk.DomainRecord
+	remaining := append([]sdk.DomainRecord{}, current...)
+
+	for _, d := range desired {
+		matched := false
+		for i, c := range remaining {
+			if equal(d, c) {
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			unmatched = append(unmatched, d)
+		}
+	}
+
+	for len(unmatched) > 0 && len(remaining) > 0 {
+		d := unmatched[0]
+		if d.TTL == 0 {
+			d.TTL = remaining[0].TTL
+		}
+
+		updates = append(updates, Change{Action: ActionUpdate, Record: d, Current: remaining[0]})
+		unmatched, remaining = unmatched[1:], remaining[1:]
+	}
+
+	for _, d := range unmatched {
+		creates = append(creates, Change{Action: ActionCreate, Record: d})
+	}
+
+	for _, c := range remaining {
+		deletes = append(deletes, Change{Action: ActionDelete, Current: c})
+	}
+
+	return creates, updates, deletes
+}
+
+// checkConflicts fails if a created record cannot coexist with a record that is
+// not going to be deleted, as a CNAME must be the only record at its name
+func checkConflicts(creates []Change, existing, managed map[key][]sdk.DomainRecord) error {
+	for _, c := range creates {
+		for k, records := range existing {
+			if k.name != c.Record.Name || len(records) == 0 || !conflicts(c.Record.Type, k.recordType) {
+				continue
+			}
+
+			if _, ok := managed[k]; !ok {
+				return fmt.Errorf("dnssync: cannot create %s %s next to unmanaged %s records",
+					c.Record.Name, strings.ToUpper(string(c.Record.Type)), strings.ToUpper(string(k.recordType)))
+			}
+		}
+	}
+
+	return nil
+}
+
+// conflicts reports whether records of types a and b cannot share a name
+func conflicts(a, b sdk.DomainRecordType) bool {
+	return a != b && (a == sdk.RecordTypeCNAME || b == sdk.RecordTypeCNAME)
+}
+
+// replacedBy reports whether d deletes a record that the create c replaces
+func replacedBy(c, d Change) bool {
+	return c.Record.Name == d.Current.Name && conflicts(c.Record.Type, d.Current.Type)
+}
+
+// orderDeletes returns the deletes, those of records replaced by a created CNAME
+// first so the name stops carrying both as soon as possible
+func orderDeletes(creates, deletes []Change) []Change {
+	var replaced, remaining []Change
+
+	for _, d := range deletes {
+		isReplaced := false
+		for _, c := range creates {
+			if replacedBy(c, d) {
+				isReplaced = true
+				break
+			}
+		}
+
+		if isReplaced {
+			replaced = append(replaced, d)
+		} else {
+			remaining = append(remaining, d)
+		}
+	}
+
+	return append(replaced, remaining...)
+}
+
+// markerChanges returns the changes to the Syncer's markers to apply before the record
+// changes, claiming every type about to be touched, and after them, releasing types
+// no longer desired
+func (s *Syncer) markerChanges(markers map[string]*marker, wanted, managed map[key][]sdk.DomainRecord) (first, last []Change) {
+	before := map[string]map[sdk.DomainRecordType]bool{}
+	after := map[string]map[sdk.DomainRecordType]bool{}
+
+	add := func(set map[string]map[sdk.DomainRecordType]bool, k key) {
+		if set[k.name] == nil {
+			set[k.name] = map[sdk.DomainRecordType]bool{}
+		}
+		set[k.name][k.recordType] = true
+	}
+
+	for k := range managed {
+		add(before, k)
+	}
+	for k := range wanted {
+		add(before, k)
+		add(after, k)
+	}
+	for name, m := range markers {
+		for t := range m.types {
+			add(before, key{name, t})
+		}
+	}
+
+	names := make([]string, 0, len(before))
+	for name := range before {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		old, hasMarker := markers[name]
+		claimed := s.markerRecord(name, before[name])
+		if !hasMarker {
+			first = append(first, Change{Action: ActionCreate, Record: claimed, Marker: true})
+		} else {
+			claimed.ID, claimed.TTL = old.record.ID, old.record.TTL
+			if !reflect.DeepEqual(old.types, before[name]) {
+				first = append(first, Change{Action: ActionUpdate, Record: claimed, Current: old.record, Marker: true})
+			}
+		}
+
+		if len(after[name]) == 0 {
+			last = append(last, Change{Action: ActionDelete, Current: claimed, Marker: true})
+		} else if !reflect.DeepEqual(after[name], before[name]) {
+			final := s.markerRecord(name, after[name])
+			final.ID, final.TTL = claimed.ID, claimed.TTL
+			last = append(last, Change{Action: ActionUpdate, Record: final, Current: claimed, Marker: true})
+		}
+	}
+
+	return first, last
+}
+
+// markerRecord builds the ownership marker of name for types
+func (s *Syncer) markerRecord(name string, types map[sdk.DomainRecordType]bool) sdk.DomainRecord {
+	names := make([]string, 0, len(types))
+	for t := range types {
+		names = append(names, string(t))
+	}
+	sort.Strings(names)
+
+	markerName := markerPrefix
+	if name != "@" {
+		markerName = markerPrefix + "." + name
+	}
+
+	return sdk.DomainRecord{
+		Name: markerName,
+		Type: sdk.RecordTypeTXT,
+		TTL:  zonefile.DefaultTTL,
+		Value: sdk.TXTRecordValue{
+			Text: fmt.Sprintf("%s owner=%s types=%s", heritage, s.owner, strings.Join(names, ",")),
+		},
+	}
+}
+
+// markedName returns the name an ownership marker named name is for
+func markedName(name string) (string, bool) {
+	if name == markerPrefix {
+		return "@", true
+	}
+
+	if strings.HasPrefix(name, markerPrefix+".") {
+		return strings.TrimPrefix(name, markerPrefix+"."), true
+	}
+
+	return "", false
+}
+
+// parseMarker parses a marker TXT record, returning nil if it was not written by this package
+func parseMarker(r sdk.DomainRecord) *marker {
+	value, ok := r.Value.(sdk.TXTRecordValue)
+	if !ok {
+		return nil
+	}
+
+	fields := strings.Fields(value.Text)
+	if len(fields) == 0 || fields[0] != heritage {
+		return nil
+	}
+
+	m := &marker{record: r, types: map[sdk.DomainRecordType]bool{}}
+	for _, f := range fields[1:] {
+		switch {
+		case strings.HasPrefix(f, "owner="):
+			m.owner = strings.TrimPrefix(f, "owner=")
+		case strings.HasPrefix(f, "types="):
+			for _, t := range strings.Split(strings.TrimPrefix(f, "types="), ",") {
+				if t != "" {
+					m.types[sdk.DomainRecordType(t)] = true
+				}
+			}
+		}
+	}
+
+	return m
+}
+
+// normalize lower cases names and types and sorts addresses so equal records compare equal
+func normalize(r sdk.DomainRecord) sdk.DomainRecord {
+	r.Name = strings.ToLower(strings.TrimSuffix(r.Name, "."))
+	if r.Name == "" {
+		r.Name = "@"
+	}
+	r.Type = sdk.DomainRecordType(strings.ToLower(string(r.Type)))
+
+	host := func(h string) string {
+		return strings.ToLower(strings.TrimSuffix(h, "."))
+	}
+
+	switch v := r.Value.(type) {
+	case []sdk.AddressRecordValue:
+		addresses := append([]sdk.AddressRecordValue{}, v...)
+		sort.Slice(addresses, func(i, j int) bool { return addresses[i].IP < addresses[j].IP })
+		r.Value = addresses
+	case sdk.HostRecordValue:
+		v.Host = host(v.Host)
+		r.Value = v
+	case sdk.MXRecordValue:
+		v.Host = host(v.Host)
+		r.Value = v
+	case sdk.SRVRecordValue:
+		v.Target = host(v.Target)
+		r.Value = v
+	case sdk.PTRRecordValue:
+		v.Domain = host(v.Domain)
+		r.Value = v
+	}
+
+	return r
+}
+
+// equal compares normalized records, ignoring the TTL of desired when it is zero
+func equal(desired, current sdk.DomainRecord) bool {
+	if desired.TTL != 0 && desired.TTL != current.TTL {
+		return false
+	}
+
+	return desired.Cloud == current.Cloud && reflect.DeepEqual(desired.Value, current.Value)
+}
+
+// sortedKeys returns the keys of all sets in a stable order
+func sortedKeys(sets ...map[key][]sdk.DomainRecord) []key {
+	seen := map[key]bool{}
+	var keys []key
+
+	for _, set := range sets {
+		for k := range set {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].name != keys[j].name {
+			return keys[i].name < keys[j].name
+		}
+		return keys[i].recordType < keys[j].recordType
+	})
+
+	return keys
+}
+
+// subject is the record a change is about
+func (c Change) subject() sdk.DomainRecord {
+	if c.Action == ActionDelete {
+		return c.Current
+	}
+
+	return c.Record
+}
+
+// Apply makes the changes of plan in order. It stops at the first failing change;
+// the error reports how many changes were applied, and planning again resumes from there.
+func (s *Syncer) Apply(ctx context.Context, plan *Plan) error {
+	// Markers created by this plan are updated or deleted by later changes of the
+	// same plan, which could not know their ID when planning
+	createdMarkers := map[string]string{}
+
+	for i, c := range plan.Changes {
+		var err error
+
+		id := c.Current.ID
+		if id == "" && c.Marker {
+			id = createdMarkers[c.Current.Name]
+		}
+
+		switch c.Action {
+		case ActionCreate:
+			var created *sdk.DomainRecord
+			created, err = s.client.CreateDomainRecord(ctx, plan.Domain, c.Record.GetCreateOptions())
+			if err == nil && c.Marker {
+				createdMarkers[c.Record.Name] = created.ID
+			}
+		case ActionUpdate:
+			_, err = s.client.UpdateDomainRecord(ctx, plan.Domain, id, c.Record.GetUpdateOptions())
+		case ActionDelete:
+			err = s.client.DeleteDomainRecord(ctx, plan.Domain, id)
+		}
+
+		if err != nil {
+			r := c.subject()
+			return fmt.Errorf("dnssync: %s %s %s failed after %d of %d changes: %w",
+				c.Action, r.Name, strings.ToUpper(string(r.Type)), i, len(plan.Changes), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package dnssync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/S4eedb/arvancloud-go/sdk"
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeClient keeps the records of a single Domain in memory and logs the calls made
+type fakeClient struct {
+	records []sdk.DomainRecord
+	nextID  int
+	calls   []string
+}
+
+func (f *fakeClient) ListDomainRecords(_ context.Context, _ string, _ *sdk.ListOptions) ([]sdk.DomainRecord, error) {
+	return append([]sdk.DomainRecord{}, f.records...), nil
+}
+
+func (f *fakeClient) CreateDomainRecord(_ context.Context, _ string, opts sdk.DomainRecordCreateOptions) (*sdk.DomainRecord, error) {
+	f.nextID++
+	r := sdk.DomainRecord{ID: fmt.Sprint("new", f.nextID), Type: opts.Type, Name: opts.Name, Value: opts.Value, TTL: opts.TTL, Cloud: opts.Cloud}
+	f.records = append(f.records, r)
+	f.calls = append(f.calls, fmt.Sprintf("create %s %s", r.Name, r.Type))
+	return &r, nil
+}
+
+func (f *fakeClient) UpdateDomainRecord(_ context.Context, _ string, id string, opts sdk.DomainRecordUpdateOptions) (*sdk.DomainRecord, error) {
+	for i, r := range f.records {
+		if r.ID == id {
+			f.records[i] = sdk.DomainRecord{ID: id, Type: opts.Type, Name: opts.Name, Value: opts.Value, TTL: opts.TTL, Cloud: opts.Cloud}
+			f.calls = append(f.calls, fmt.Sprintf("update %s %s", opts.Name, opts.Type))
+			return &f.records[i], nil
+		}
+	}
+	return nil, fmt.Errorf("record %q not found", id)
+}
+
+func (f *fakeClient) DeleteDomainRecord(_ context.Context, _ string, id string) error {
+	for i, r := range f.records {
+		if r.ID == id {
+			f.records = append(f.records[:i], f.records[i+1:]...)
+			f.calls = append(f.calls, fmt.Sprintf("delete %s %s", r.Name, r.Type))
+			return nil
+		}
+	}
+	return fmt.Errorf("record %q not found", id)
+}
+
+func a(name string, ips ...string) sdk.DomainRecord {
+	values := []sdk.AddressRecordValue{}
+	for _, ip := range ips {
+		values = append(values, sdk.AddressRecordValue{IP: ip})
+	}
+	return sdk.DomainRecord{Name: name, Type: sdk.RecordTypeA, TTL: 300, Value: values}
+}
+
+func cname(name, host string) sdk.DomainRecord {
+	return sdk.DomainRecord{Name: name, Type: sdk.RecordTypeCNAME, TTL: 300, Value: sdk.HostRecordValue{Host: host}}
+}
+
+func syncTwice(t *testing.T, syncer *Syncer, desired []sdk.DomainRecord) *Plan {
+	t.Helper()
+
+	plan, err := syncer.Plan(context.Background(), "example.com", desired)
+	if err != nil {
+		t.Fatalf("Error planning: %s", err)
+	}
+
+	if err := syncer.Apply(context.Background(), plan); err != nil {
+		t.Fatalf("Error applying: %s", err)
+	}
+
+	again, err := syncer.Plan(context.Background(), "example.com", desired)
+	if err != nil {
+		t.Fatalf("Error planning again: %s", err)
+	}
+
+	if !again.Empty() {
+		t.Errorf("expected no changes after apply, got:\n%s", again)
+	}
+
+	return plan
+}
+
+func TestSync_leavesUnmanagedRecords(t *testing.T) {
+	unmanaged := a("legacy", "192.0.2.9")
+	unmanaged.ID = "legacy"
+	client := &fakeClient{records: []sdk.DomainRecord{unmanaged}}
+	syncer := New(client, "ops")
+
+	syncTwice(t, syncer, []sdk.DomainRecord{a("www", "192.0.2.1")})
+	syncTwice(t, syncer, nil)
+
+	expected := []string{
+		"create _dnssync.www txt",
+		"create www a",
+		"delete www a",
+		"delete _dnssync.www txt",
+	}
+	if !cmp.Equal(client.calls, expected) {
+		t.Error(cmp.Diff(client.calls, expected))
+	}
+
+	if len(client.records) != 1 || client.records[0].ID != "legacy" {
+		t.Errorf("expected only the unmanaged record to remain, got %#v", client.records)
+	}
+}
+
+func TestSync_cnameTargetUpdatedInPlace(t *testing.T) {
+	client := &fakeClient{}
+	syncer := New(client, "ops")
+
+	syncTwice(t, syncer, []sdk.DomainRecord{cname("www", "a.example.net")})
+	client.calls = nil
+
+	plan := syncTwice(t, syncer, []sdk.DomainRecord{cname("www", "b.example.net.")})
+
+	if expected := []string{"update www cname"}; !cmp.Equal(client.calls, expected) {
+		t.Error(cmp.Diff(client.calls, expected))
+	}
+
+	diff := plan.String()
+	if !strings.Contains(diff, "  - www\t300\tIN\tCNAME\ta.example.net.\n  + www\t300\tIN\tCNAME\tb.example.net.\n") {
+		t.Errorf("unexpected diff:\n%s", diff)
+	}
+}
+
+func TestSync_swapToCNAME(t *testing.T) {
+	client := &fakeClient{}
+	syncer := New(client, "ops")
+
+	syncTwice(t, syncer, []sdk.DomainRecord{a("www", "192.0.2.1"), a("api", "192.0.2.2")})
+	client.calls = nil
+
+	syncTwice(t, syncer, []sdk.DomainRecord{cname("www", "lb.example.net"), cname("static", "cdn.example.net")})
+
+	expected := []string{
+		"create _dnssync.static txt",
+		"update _dnssync.www txt",
+		"create static cname",
+		"create www cname",
+		"delete www a",
+		"delete api a",
+		"delete _dnssync.api txt",
+		"update _dnssync.www txt",
+	}
+	if !cmp.Equal(client.calls, expected) {
+		t.Error(cmp.Diff(client.calls, expected))
+	}
+}
+
+func TestPlan_refusesUnownedRecords(t *testing.T) {
+	existing := a("www", "192.0.2.1")
+	existing.ID = "www"
+	client := &fakeClient{records: []sdk.DomainRecord{existing}}
+	syncer := New(client, "ops")
+
+	if _, err := syncer.Plan(context.Background(), "example.com", []sdk.DomainRecord{a("www", "192.0.2.2")}); err == nil {
+		t.Fatal("expected error planning over an unowned record")
+	}
+
+	syncer.AdoptExisting = true
+	syncTwice(t, syncer, []sdk.DomainRecord{a("www", "192.0.2.2")})
+
+	expected := []string{"create _dnssync.www txt", "update www a"}
+	if !cmp.Equal(client.calls, expected) {
+		t.Error(cmp.Diff(client.calls, expected))
+	}
+}
+
+func TestPlan_refusesCNAMENextToUnmanagedRecords(t *testing.T) {
+	existing := sdk.DomainRecord{ID: "mx", Name: "www", Type: sdk.RecordTypeMX, Value: sdk.MXRecordValue{Host: "mx.example.com"}}
+	client := &fakeClient{records: []sdk.DomainRecord{existing}}
+
+	_, err := New(client, "ops").Plan(context.Background(), "example.com", []sdk.DomainRecord{cname("www", "lb.example.net")})
+	if err == nil {
+		t.Fatal("expected error planning a CNAME next to an unmanaged record")
+	}
+}
+
+func TestSync_ownersShareName(t *testing.T) {
+	client := &fakeClient{}
+	ops, web := New(client, "ops"), New(client, "web")
+	txt := sdk.DomainRecord{Name: "www", Type: sdk.RecordTypeTXT, TTL: 300, Value: sdk.TXTRecordValue{Text: "site-verification=abc"}}
+
+	syncTwice(t, ops, []sdk.DomainRecord{a("www", "192.0.2.1")})
+	syncTwice(t, web, []sdk.DomainRecord{txt})
+
+	if _, err := web.Plan(context.Background(), "example.com", []sdk.DomainRecord{txt, a("www", "192.0.2.2")}); err == nil {
+		t.Fatal("expected error planning over a record set of another owner")
+	}
+
+	syncTwice(t, web, nil)
+	syncTwice(t, ops, []sdk.DomainRecord{a("www", "192.0.2.1")})
+
+	expected := []string{
+		"create _dnssync.www txt",
+		"create www a",
+		"create _dnssync.www txt",
+		"create www txt",
+		"delete www txt",
+		"delete _dnssync.www txt",
+	}
+	if !cmp.Equal(client.calls, expected) {
+		t.Error(cmp.Diff(client.calls, expected))
+	}
+
+	if len(client.records) != 2 {
+		t.Errorf("expected the A record of ops and its marker to remain, got %#v", client.records)
+	}
+}
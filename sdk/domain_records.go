@@ -0,0 +1,295 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// DomainRecordType constants start with RecordType and include Arvancloud DNS record types
+type DomainRecordType string
+
+// DomainRecordType enums
+const (
+	RecordTypeA     DomainRecordType = "a"
+	RecordTypeAAAA  DomainRecordType = "aaaa"
+	RecordTypeCNAME DomainRecordType = "cname"
+	RecordTypeANAME DomainRecordType = "aname"
+	RecordTypeMX    DomainRecordType = "mx"
+	RecordTypeNS    DomainRecordType = "ns"
+	RecordTypeTXT   DomainRecordType = "txt"
+	RecordTypeSRV   DomainRecordType = "srv"
+	RecordTypeCAA   DomainRecordType = "caa"
+	RecordTypePTR   DomainRecordType = "ptr"
+)
+
+// AddressRecordValue is one address of an A or AAAA record. A record holds
+// a []AddressRecordValue, traffic is spread over the addresses by Weight.
+type AddressRecordValue struct {
+	IP      string `json:"ip"`
+	Port    int    `json:"port,omitempty"`
+	Weight  int    `json:"weight,omitempty"`
+	Country string `json:"country,omitempty"`
+}
+
+// HostRecordValue is the value of CNAME, ANAME and NS records
+type HostRecordValue struct {
+	Host string `json:"host"`
+	// HostHeader is the Host header sent to the target of a proxied CNAME or ANAME record
+	HostHeader string `json:"host_header,omitempty"`
+}
+
+// MXRecordValue is the value of an MX record
+type MXRecordValue struct {
+	Host     string `json:"host"`
+	Priority int    `json:"priority"`
+}
+
+// TXTRecordValue is the value of a TXT record
+type TXTRecordValue struct {
+	Text string `json:"text"`
+}
+
+// SRVRecordValue is the value of an SRV record
+type SRVRecordValue struct {
+	Target   string `json:"target"`
+	Port     int    `json:"port"`
+	Weight   int    `json:"weight"`
+	Priority int    `json:"priority"`
+}
+
+// CAARecordValue is the value of a CAA record
+type CAARecordValue struct {
+	// Flag is the flags byte of the record, 128 marks the property as critical
+	Flag  int    `json:"flag,omitempty"`
+	Tag   string `json:"tag"`
+	Value string `json:"value"`
+}
+
+// PTRRecordValue is the value of a PTR record
+type PTRRecordValue struct {
+	Domain string `json:"domain"`
+}
+
+// DomainRecord represents a DNS record of a Domain. Name is relative to the
+// Domain, "@" for the apex; host names in values are absolute, without a trailing dot.
+//
+// Value holds the typed value matching Type: []AddressRecordValue for A and AAAA
+// records, HostRecordValue for CNAME, ANAME and NS, MXRecordValue, TXTRecordValue,
+// SRVRecordValue, CAARecordValue or PTRRecordValue.
+type DomainRecord struct {
+	ID    string           `json:"id"`
+	Type  DomainRecordType `json:"type"`
+	Name  string           `json:"name"`
+	Value interface{}      `json:"value"`
+	TTL   int              `json:"ttl"`
+	// Cloud proxies the record through the CDN
+	Cloud bool `json:"cloud"`
+}
+
+// UnmarshalJSON decodes the record's Value into the type matching its Type
+func (r *DomainRecord) UnmarshalJSON(b []byte) error {
+	type Mask DomainRecord
+
+	p := struct {
+		*Mask
+		Value json.RawMessage `json:"value"`
+	}{
+		Mask: (*Mask)(r),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	value, err := decodeRecordValue(r.Type, p.Value)
+	if err != nil {
+		return err
+	}
+	r.Value = value
+
+	return nil
+}
+
+func decodeRecordValue(recordType DomainRecordType, raw json.RawMessage) (interface{}, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	switch DomainRecordType(strings.ToLower(string(recordType))) {
+	case RecordTypeA, RecordTypeAAAA:
+		value := []AddressRecordValue{}
+		err := json.Unmarshal(raw, &value)
+		return value, err
+	case RecordTypeCNAME, RecordTypeANAME, RecordTypeNS:
+		value := HostRecordValue{}
+		err := json.Unmarshal(raw, &value)
+		return value, err
+	case RecordTypeMX:
+		value := MXRecordValue{}
+		err := json.Unmarshal(raw, &value)
+		return value, err
+	case RecordTypeTXT:
+		value := TXTRecordValue{}
+		err := json.Unmarshal(raw, &value)
+		return value, err
+	case RecordTypeSRV:
+		value := SRVRecordValue{}
+		err := json.Unmarshal(raw, &value)
+		return value, err
+	case RecordTypeCAA:
+		value := CAARecordValue{}
+		err := json.Unmarshal(raw, &value)
+		return value, err
+	case RecordTypePTR:
+		value := PTRRecordValue{}
+		err := json.Unmarshal(raw, &value)
+		return value, err
+	}
+
+	// Keep values of record types this package does not know as raw JSON
+	return raw, nil
+}
+
+// DomainRecordCreateOptions fields are those accepted by CreateDomainRecord
+type DomainRecordCreateOptions struct {
+	Type  DomainRecordType `json:"type"`
+	Name  string           `json:"name"`
+	Value interface{}      `json:"value"`
+	TTL   int              `json:"ttl,omitempty"`
+	Cloud bool             `json:"cloud"`
+}
+
+// DomainRecordUpdateOptions fields are those accepted by UpdateDomainRecord.
+// The Type of a record cannot be changed.
+type DomainRecordUpdateOptions struct {
+	Type  DomainRecordType `json:"type"`
+	Name  string           `json:"name"`
+	Value interface{}      `json:"value"`
+	TTL   int              `json:"ttl,omitempty"`
+	Cloud bool             `json:"cloud"`
+}
+
+// GetCreateOptions converts a DomainRecord to DomainRecordCreateOptions for use in CreateDomainRecord
+func (r DomainRecord) GetCreateOptions() DomainRecordCreateOptions {
+	return DomainRecordCreateOptions{
+		Type:  r.Type,
+		Name:  r.Name,
+		Value: r.Value,
+		TTL:   r.TTL,
+		Cloud: r.Cloud,
+	}
+}
+
+// GetUpdateOptions converts a DomainRecord to DomainRecordUpdateOptions for use in UpdateDomainRecord
+func (r DomainRecord) GetUpdateOptions() DomainRecordUpdateOptions {
+	return DomainRecordUpdateOptions{
+		Type:  r.Type,
+		Name:  r.Name,
+		Value: r.Value,
+		TTL:   r.TTL,
+		Cloud: r.Cloud,
+	}
+}
+
+// DomainRecordsPagedResponse represents a paginated DomainRecord API response
+type DomainRecordsPagedResponse struct {
+	*PageOptions
+	Data []DomainRecord `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for DomainRecords of a Domain
+func (DomainRecordsPagedResponse) endpointWithID(c *Client, domain string) string {
+	endpoint, err := c.DomainRecords.endpointWithParams(domain)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends DomainRecords when processing paginated DomainRecord responses
+func (resp *DomainRecordsPagedResponse) appendData(r *DomainRecordsPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListDomainRecords lists the DNS records of a Domain
+func (c *Client) ListDomainRecords(ctx context.Context, domain string, opts *ListOptions) ([]DomainRecord, error) {
+	response := DomainRecordsPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, domain, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetDomainRecord gets a DNS record of a Domain
+func (c *Client) GetDomainRecord(ctx context.Context, domain, recordID string) (*DomainRecord, error) {
+	e, err := c.DomainRecords.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, recordID)
+
+	record := &DomainRecord{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(record)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// CreateDomainRecord creates a DNS record on a Domain
+func (c *Client) CreateDomainRecord(ctx context.Context, domain string, createOpts DomainRecordCreateOptions) (*DomainRecord, error) {
+	e, err := c.DomainRecords.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	record := &DomainRecord{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(record)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// UpdateDomainRecord updates a DNS record of a Domain
+func (c *Client) UpdateDomainRecord(ctx context.Context, domain, recordID string, updateOpts DomainRecordUpdateOptions) (*DomainRecord, error) {
+	e, err := c.DomainRecords.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, recordID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	record := &DomainRecord{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(record)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// DeleteDomainRecord deletes a DNS record of a Domain
+func (c *Client) DeleteDomainRecord(ctx context.Context, domain, recordID string) error {
+	e, err := c.DomainRecords.endpointWithParams(domain)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, recordID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
@@ -0,0 +1,45 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListDomainRecords_typedValues(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cdn/4.0/domains/example.com/dns-records" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		writeJSON(w, http.StatusOK, `{"data":[
+			{"id":"1","type":"a","name":"@","ttl":120,"cloud":true,"value":[{"ip":"192.0.2.1","weight":100}]},
+			{"id":"2","type":"mx","name":"@","ttl":3600,"value":{"host":"mx.example.com","priority":10}},
+			{"id":"3","type":"tlsa","name":"_443._tcp","ttl":3600,"value":{"usage":3}}
+		]}`)
+	})
+
+	records, err := client.ListDomainRecords(context.Background(), "example.com", nil)
+	if err != nil {
+		t.Fatalf("Error listing domain records: %s", err)
+	}
+
+	if len(records) != 3 {
+		t.Fatalf("expected 3 records, got %d", len(records))
+	}
+
+	expected := []DomainRecord{
+		{ID: "1", Type: RecordTypeA, Name: "@", TTL: 120, Cloud: true, Value: []AddressRecordValue{{IP: "192.0.2.1", Weight: 100}}},
+		{ID: "2", Type: RecordTypeMX, Name: "@", TTL: 3600, Value: MXRecordValue{Host: "mx.example.com", Priority: 10}},
+	}
+	if !cmp.Equal(records[:2], expected) {
+		t.Error(cmp.Diff(records[:2], expected))
+	}
+
+	if _, ok := records[2].Value.(json.RawMessage); !ok {
+		t.Errorf("expected unknown record value to be kept raw, got %T", records[2].Value)
+	}
+}
@@ -2,9 +2,22 @@ package sdk
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
+// DomainType is how the DNS of a Domain is served by Arvancloud
+type DomainType string
+
+// DomainType enums
+const (
+	// DomainTypeFull serves all DNS records of the Domain from Arvancloud name servers
+	DomainTypeFull DomainType = "full"
+	// DomainTypePartial keeps the Domain's name servers, records are pointed at Arvancloud with CNAMEs
+	DomainTypePartial DomainType = "partial"
+)
+
 // Domain represents a Domain object
 type Domain struct {
 	ID       string `json:"id"`
@@ -41,6 +54,12 @@ type Domain struct {
 	UpdatedAt          time.Time `json:"updated_at"`
 }
 
+// DomainCreateOptions fields are those accepted by CreateDomain
+type DomainCreateOptions struct {
+	Domain     string     `json:"domain"`
+	DomainType DomainType `json:"domain_type,omitempty"`
+}
+
 // ListDomains lists Domains
 func (c *Client) ListDomains(ctx context.Context, opts *ListOptions) ([]Domain, error) {
 	response := DomainsPagedResponse{}
@@ -72,3 +91,52 @@ func (DomainsPagedResponse) endpoint(c *Client) string {
 
 	return endpoint
 }
+
+// GetDomain gets a Domain by name
+func (c *Client) GetDomain(ctx context.Context, domain string) (*Domain, error) {
+	e, err := c.Domains.Endpoint()
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, domain)
+
+	d := &Domain{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(d)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// CreateDomain adds a Domain to the account
+func (c *Client) CreateDomain(ctx context.Context, createOpts DomainCreateOptions) (*Domain, error) {
+	e, err := c.Domains.Endpoint()
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/dns-service", e)
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	d := &Domain{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(d)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DeleteDomain removes a Domain and all of its settings from the account
+func (c *Client) DeleteDomain(ctx context.Context, domain string) error {
+	e, err := c.Domains.Endpoint()
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, domain)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
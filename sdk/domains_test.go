@@ -0,0 +1,72 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDomains(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		switch r.Method {
+		case http.MethodDelete:
+			writeJSON(w, http.StatusOK, `{"message":"Domain removed."}`)
+		case http.MethodPost:
+			writeJSON(w, http.StatusCreated, `{"data":{"id":"d1","domain":"example.com","name":"example.com","status":"pending"}}`)
+		default:
+			writeJSON(w, http.StatusOK, `{"data":{"id":"d1","domain":"example.com","name":"example.com","services":{"dns":"cloud","cdn":true},"status":"active"}}`)
+		}
+	})
+
+	ctx := context.Background()
+
+	domain, err := client.CreateDomain(ctx, DomainCreateOptions{Domain: "example.com", DomainType: DomainTypeFull})
+	if err != nil {
+		t.Fatalf("Error creating domain: %s", err)
+	}
+
+	if domain.ID != "d1" || domain.Status != "pending" {
+		t.Errorf("unexpected domain %#v", domain)
+	}
+
+	domain, err = client.GetDomain(ctx, "example.com")
+	if err != nil {
+		t.Fatalf("Error getting domain: %s", err)
+	}
+
+	if domain.Status != "active" || domain.Services.DNS != "cloud" || !domain.Services.Cdn {
+		t.Errorf("unexpected domain %#v", domain)
+	}
+
+	if err := client.DeleteDomain(ctx, "example.com"); err != nil {
+		t.Fatalf("Error deleting domain: %s", err)
+	}
+
+	expected := []string{
+		`POST /cdn/4.0/domains/dns-service {"domain":"example.com","domain_type":"full"}`,
+		"GET /cdn/4.0/domains/example.com ",
+		"DELETE /cdn/4.0/domains/example.com ",
+	}
+	if !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+}
+
+func TestGetDomain_notFound(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, `{"message":"Domain not found.","errors":{"domain":["Domain not found."]}}`)
+	})
+
+	if _, err := client.GetDomain(context.Background(), "example.org"); err == nil {
+		t.Fatal("expected error getting a missing domain")
+	}
+}
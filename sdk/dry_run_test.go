@@ -0,0 +1,116 @@
+package sdk
+
+import (
+	"context"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient_SetDryRun(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request %s %s sent during dry-run", r.Method, r.URL.Path)
+		}
+
+		writeJSON(w, http.StatusOK, `{"data":{"id":"r1","type":"cname","name":"www","value":{"host":"example.net"},"ttl":300}}`)
+	})
+	client.SetDryRun(true)
+
+	record, err := client.GetDomainRecord(context.Background(), "example.com", "r1")
+	if err != nil {
+		t.Fatalf("Error getting record: %s", err)
+	}
+
+	updateOpts := record.GetUpdateOptions()
+	updateOpts.Value = HostRecordValue{Host: "example.org"}
+
+	updated, err := client.UpdateDomainRecord(context.Background(), "example.com", record.ID, updateOpts)
+	if err != nil {
+		t.Fatalf("Error updating record: %s", err)
+	}
+
+	if !cmp.Equal(updated, &DomainRecord{}) {
+		t.Errorf("expected a zero-valued synthetic result, got %#v", updated)
+	}
+
+	if err := client.DeleteDomainRecord(context.Background(), "example.com", record.ID); err != nil {
+		t.Fatalf("Error deleting record: %s", err)
+	}
+
+	expected := []DryRunRequest{
+		{
+			Method:   http.MethodPut,
+			Endpoint: "domains/example.com/dns-records/r1",
+			Body:     []byte(`{"type":"cname","name":"www","value":{"host":"example.org"},"ttl":300,"cloud":false}`),
+		},
+		{
+			Method:   http.MethodDelete,
+			Endpoint: "domains/example.com/dns-records/r1",
+		},
+	}
+	if requests := client.DryRunRequests(); !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+
+	client.ResetDryRunRequests()
+	if requests := client.DryRunRequests(); len(requests) != 0 {
+		t.Errorf("expected no requests after reset, got %#v", requests)
+	}
+}
+
+func TestClient_SetDryRun_resultShapes(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s sent during dry-run", r.Method, r.URL.Path)
+	})
+	client.SetDryRun(true)
+
+	origins, err := client.SetLoadBalancerOriginStates(context.Background(), "example.com", "p1", []LoadBalancerOriginState{{ID: "o1", Enabled: true}})
+	if err != nil {
+		t.Fatalf("Error setting origin states: %s", err)
+	}
+
+	if len(origins) != 0 {
+		t.Errorf("expected no origins, got %#v", origins)
+	}
+
+	record, err := client.CreateDomainRecord(context.Background(), "example.com", DomainRecordCreateOptions{
+		Type:  RecordTypeCNAME,
+		Name:  "www",
+		Value: HostRecordValue{Host: "example.net"},
+	})
+	if err != nil {
+		t.Fatalf("Error creating record: %s", err)
+	}
+
+	if !cmp.Equal(record, &DomainRecord{}) {
+		t.Errorf("expected a zero-valued record, got %#v", record)
+	}
+}
+
+func TestClient_SetRootCertificate_afterSetDryRun(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"data":{"id":"r1","type":"cname","name":"www","value":{"host":"example.net"},"ttl":300}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	certPath := filepath.Join(t.TempDir(), "ca.pem")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := ioutil.WriteFile(certPath, cert, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewClient("MYFAKEAPIKEY")
+	client.SetBaseURL(server.URL)
+	client.SetDryRun(true)
+	client.SetRootCertificate(certPath)
+
+	if _, err := client.GetDomainRecord(context.Background(), "example.com", "r1"); err != nil {
+		t.Fatalf("Error getting record over TLS: %s", err)
+	}
+}
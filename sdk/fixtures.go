@@ -0,0 +1,306 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FixtureMode selects whether a Client records its API exchanges to a fixture file or replays them from it
+type FixtureMode string
+
+// FixtureMode enums
+const (
+	FixtureModeOff    FixtureMode = ""
+	FixtureModeRecord FixtureMode = "record"
+	FixtureModeReplay FixtureMode = "replay"
+)
+
+// fixtureRedacted replaces scrubbed values in fixture files
+const fixtureRedacted = "REDACTED"
+
+// fixtureScrubbedHeaders are not written to fixture files
+var fixtureScrubbedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// fixtureScrubbedFields are JSON fields holding account details, their values are
+// replaced in the request and response bodies written to fixture files
+var fixtureScrubbedFields = map[string]bool{
+	"first_name": true,
+	"last_name":  true,
+	"email":      true,
+	"company":    true,
+	"address_1":  true,
+	"address_2":  true,
+	"city":       true,
+	"state":      true,
+	"zip":        true,
+	"tax_id":     true,
+	"phone":      true,
+	"mobile":     true,
+	"user_id":    true,
+}
+
+// FixtureInteraction is a request and its response as stored in a fixture file
+type FixtureInteraction struct {
+	Request  FixtureRequest  `json:"request"`
+	Response FixtureResponse `json:"response"`
+}
+
+// FixtureRequest is a recorded request. URL is relative to the CDN base URL, or to the API
+// host for other products.
+type FixtureRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// FixtureResponse is a recorded response
+type FixtureResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// fixture is the content of a fixture file
+type fixture struct {
+	Interactions []FixtureInteraction `json:"interactions"`
+}
+
+// fixtureTransport records exchanges to, or replays them from, a fixture file
+type fixtureTransport struct {
+	mode    FixtureMode
+	path    string
+	baseURL func() string
+	next    http.RoundTripper
+
+	mu       sync.Mutex
+	fixture  fixture
+	replayed []bool
+}
+
+func newFixtureTransport(mode FixtureMode, path string, baseURL func() string, next http.RoundTripper) (*fixtureTransport, error) {
+	if path == "" {
+		return nil, fmt.Errorf("no fixture path for fixture mode %q", mode)
+	}
+
+	t := &fixtureTransport{mode: mode, path: path, baseURL: baseURL, next: next}
+
+	switch mode {
+	case FixtureModeRecord:
+		// Start from an empty fixture, the file is written after each exchange
+		return t, t.save()
+	case FixtureModeReplay:
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &t.fixture); err != nil {
+			return nil, fmt.Errorf("failed to parse fixture %s: %w", path, err)
+		}
+		t.replayed = make([]bool, len(t.fixture.Interactions))
+
+		return t, nil
+	}
+
+	return nil, fmt.Errorf("unknown fixture mode %q", mode)
+}
+
+// relativeURL returns the URL of a request relative to the API base URL
+func (t *fixtureTransport) relativeURL(req *http.Request) string {
+	return relativeEndpoint(t.baseURL(), req.URL.String())
+}
+
+func (t *fixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	request := FixtureRequest{
+		Method: req.Method,
+		URL:    t.relativeURL(req),
+		Header: scrubHeader(req.Header),
+		Body:   scrubBody(body),
+	}
+
+	if t.mode == FixtureModeReplay {
+		return t.replay(req, request)
+	}
+
+	return t.record(req, request)
+}
+
+func (t *fixtureTransport) record(req *http.Request, request FixtureRequest) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.fixture.Interactions = append(t.fixture.Interactions, FixtureInteraction{
+		Request: request,
+		Response: FixtureResponse{
+			StatusCode: resp.StatusCode,
+			Header:     scrubHeader(resp.Header),
+			Body:       scrubBody(body),
+		},
+	})
+
+	if err := t.save(); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// replay answers a request with the first interaction not yet replayed that has the
+// same method, URL and body, so repeated requests get their responses in recorded order
+func (t *fixtureTransport) replay(req *http.Request, request FixtureRequest) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i, interaction := range t.fixture.Interactions {
+		recorded := interaction.Request
+		if t.replayed[i] || recorded.Method != request.Method || recorded.URL != request.URL || recorded.Body != request.Body {
+			continue
+		}
+		t.replayed[i] = true
+
+		body := []byte(interaction.Response.Body)
+		header := interaction.Response.Header
+		if header == nil {
+			header = http.Header{}
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header.Clone(),
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no fixture in %s for %s %s", t.path, request.Method, request.URL)
+}
+
+func (t *fixtureTransport) save() error {
+	b, err := json.MarshalIndent(t.fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(t.path, append(b, '\n'), 0o644)
+}
+
+func scrubHeader(header http.Header) http.Header {
+	scrubbed := header.Clone()
+	for _, name := range fixtureScrubbedHeaders {
+		scrubbed.Del(name)
+	}
+
+	if len(scrubbed) == 0 {
+		return nil
+	}
+
+	return scrubbed
+}
+
+// scrubBody replaces the values of fixtureScrubbedFields in a JSON body,
+// other bodies are returned unchanged
+func scrubBody(body []byte) string {
+	var v interface{}
+
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return string(body)
+	}
+
+	if !scrubValue(v) {
+		return string(body)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return string(body)
+	}
+
+	return string(b)
+}
+
+// scrubValue scrubs a decoded JSON value in place, reporting whether anything was replaced
+func scrubValue(v interface{}) bool {
+	scrubbed := false
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			if _, ok := value.(string); ok && fixtureScrubbedFields[k] {
+				v[k] = fixtureRedacted
+				scrubbed = true
+				continue
+			}
+
+			scrubbed = scrubValue(value) || scrubbed
+		}
+	case []interface{}:
+		for _, value := range v {
+			scrubbed = scrubValue(value) || scrubbed
+		}
+	}
+
+	return scrubbed
+}
+
+// SetFixtures records the API exchanges of the Client to the fixture file at path, or
+// replays them from it without sending any request, depending on mode.
+// FixtureModeOff leaves the transport unchanged.
+func (c *Client) SetFixtures(mode FixtureMode, path string) error {
+	if mode == FixtureModeOff {
+		return nil
+	}
+
+	next := c.resty.GetClient().Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	restyClient := c.resty
+	t, err := newFixtureTransport(mode, path, func() string { return restyClient.HostURL }, next)
+	if err != nil {
+		return err
+	}
+	c.resty.SetTransport(t)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestClient_SetFixtures(t *testing.T) {
+	fixturePath := filepath.Join(t.TempDir(), "fixtures", "account.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"email":"jane@example.com","phone":"+98 21 0000","balance":12.5,"country":"IR"}`)
+	}))
+
+	t.Setenv(FixtureModeVar, string(FixtureModeRecord))
+	t.Setenv(FixturePathVar, fixturePath)
+
+	client := NewClient("MYFAKEAPIKEY")
+	client.SetBaseURL(server.URL)
+
+	account, err := client.GetAccount(context.Background())
+	if err != nil {
+		t.Fatalf("Error getting account: %s", err)
+	}
+
+	if account.Email != "jane@example.com" {
+		t.Errorf("expected the recorded response to be passed through, got %#v", account)
+	}
+
+	server.Close()
+
+	b, err := ioutil.ReadFile(fixturePath)
+	if err != nil {
+		t.Fatalf("Error reading fixture: %s", err)
+	}
+
+	for _, secret := range []string{"MYFAKEAPIKEY", "jane@example.com", "+98 21 0000"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("expected %q to be scrubbed from fixture:\n%s", secret, b)
+		}
+	}
+
+	// Replay against a base URL that does not exist to make sure nothing is sent
+	t.Setenv(FixtureModeVar, string(FixtureModeReplay))
+
+	client = NewClient("MYFAKEAPIKEY")
+	client.SetBaseURL("http://arvancloud.invalid")
+
+	account, err = client.GetAccount(context.Background())
+	if err != nil {
+		t.Fatalf("Error replaying account: %s", err)
+	}
+
+	if account.Email != fixtureRedacted || account.Balance != 12.5 || account.Country != "IR" {
+		t.Errorf("unexpected replayed account %#v", account)
+	}
+
+	if _, err := client.ListDomains(context.Background(), nil); err == nil || !strings.Contains(err.Error(), "no fixture") {
+		t.Errorf("expected a missing fixture error, got %v", err)
+	}
+}
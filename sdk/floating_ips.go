@@ -0,0 +1,157 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// FloatingIPStatus is whether a FloatingIP is attached to a server
+type FloatingIPStatus string
+
+// FloatingIPStatus enums
+const (
+	FloatingIPStatusActive FloatingIPStatus = "ACTIVE"
+	FloatingIPStatusDown   FloatingIPStatus = "DOWN"
+)
+
+// FloatingIP is a public address allocated to a region, which can be moved between its servers
+type FloatingIP struct {
+	ID          string           `json:"id"`
+	Address     string           `json:"address"`
+	Status      FloatingIPStatus `json:"status"`
+	Description string           `json:"description"`
+	// ServerID is the server the address is attached to, if any
+	ServerID string `json:"server_id"`
+	// FixedAddress is the private address of the server the address is routed to
+	FixedAddress string `json:"fixed_address"`
+}
+
+// FloatingIPCreateOptions fields are those accepted by AllocateFloatingIP
+type FloatingIPCreateOptions struct {
+	Description string `json:"description,omitempty"`
+}
+
+// FloatingIPAttachOptions fields are those accepted by AttachFloatingIP
+type FloatingIPAttachOptions struct {
+	ServerID string `json:"server_id"`
+	// SubnetID selects the port of the server to route to, its public port when empty
+	SubnetID string `json:"subnet_id,omitempty"`
+}
+
+// FloatingIPsPagedResponse represents a paginated FloatingIP API response
+type FloatingIPsPagedResponse struct {
+	*PageOptions
+	Data []FloatingIP `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for FloatingIPs of a region
+func (FloatingIPsPagedResponse) endpointWithID(c *Client, region string) string {
+	endpoint, err := c.FloatingIPs.endpointWithParams(region)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends FloatingIPs when processing paginated FloatingIP responses
+func (resp *FloatingIPsPagedResponse) appendData(r *FloatingIPsPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListFloatingIPs lists the FloatingIPs allocated to a region
+func (c *Client) ListFloatingIPs(ctx context.Context, region string, opts *ListOptions) ([]FloatingIP, error) {
+	response := FloatingIPsPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, region, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetFloatingIP gets a FloatingIP of a region
+func (c *Client) GetFloatingIP(ctx context.Context, region, floatingIPID string) (*FloatingIP, error) {
+	e, err := c.FloatingIPs.endpointWithParams(region)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, floatingIPID)
+
+	ip := &FloatingIP{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(ip)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return ip, nil
+}
+
+// AllocateFloatingIP allocates a FloatingIP to a region
+func (c *Client) AllocateFloatingIP(ctx context.Context, region string, createOpts FloatingIPCreateOptions) (*FloatingIP, error) {
+	e, err := c.FloatingIPs.endpointWithParams(region)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	ip := &FloatingIP{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(ip)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return ip, nil
+}
+
+// AttachFloatingIP routes a FloatingIP to a Server
+func (c *Client) AttachFloatingIP(ctx context.Context, region, floatingIPID string, attachOpts FloatingIPAttachOptions) (*FloatingIP, error) {
+	e, err := c.FloatingIPs.endpointWithParams(region)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s/attach", e, floatingIPID)
+
+	bodyData, err := json.Marshal(attachOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	ip := &FloatingIP{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(ip)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return ip, nil
+}
+
+// DetachFloatingIP detaches a FloatingIP from its Server, keeping it allocated to the region
+func (c *Client) DetachFloatingIP(ctx context.Context, region, floatingIPID string) (*FloatingIP, error) {
+	e, err := c.FloatingIPs.endpointWithParams(region)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s/detach", e, floatingIPID)
+
+	ip := &FloatingIP{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(ip)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return ip, nil
+}
+
+// ReleaseFloatingIP releases a FloatingIP of a region, its address may be allocated to others
+func (c *Client) ReleaseFloatingIP(ctx context.Context, region, floatingIPID string) error {
+	e, err := c.FloatingIPs.endpointWithParams(region)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, floatingIPID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFloatingIPs(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		switch r.URL.Path {
+		case "/ecc/v1/regions/ir-thr-c2/float-ips/ip1/attach":
+			writeJSON(w, http.StatusOK, `{"data":{"id":"ip1","address":"185.1.2.3","status":"ACTIVE","server_id":"s1"}}`)
+		default:
+			writeJSON(w, http.StatusOK, `{"data":{"id":"ip1","address":"185.1.2.3","status":"DOWN"}}`)
+		}
+	})
+
+	ctx := context.Background()
+
+	ip, err := client.AllocateFloatingIP(ctx, "ir-thr-c2", FloatingIPCreateOptions{Description: "preview"})
+	if err != nil {
+		t.Fatalf("Error allocating floating IP: %s", err)
+	}
+
+	attached, err := client.AttachFloatingIP(ctx, "ir-thr-c2", ip.ID, FloatingIPAttachOptions{ServerID: "s1"})
+	if err != nil {
+		t.Fatalf("Error attaching floating IP: %s", err)
+	}
+
+	if attached.Status != FloatingIPStatusActive || attached.ServerID != "s1" {
+		t.Errorf("expected the floating IP to be attached to s1, got %#v", attached)
+	}
+
+	if _, err := client.DetachFloatingIP(ctx, "ir-thr-c2", ip.ID); err != nil {
+		t.Fatalf("Error detaching floating IP: %s", err)
+	}
+
+	if err := client.ReleaseFloatingIP(ctx, "ir-thr-c2", ip.ID); err != nil {
+		t.Fatalf("Error releasing floating IP: %s", err)
+	}
+
+	expected := []string{
+		`POST /ecc/v1/regions/ir-thr-c2/float-ips {"description":"preview"}`,
+		`POST /ecc/v1/regions/ir-thr-c2/float-ips/ip1/attach {"server_id":"s1"}`,
+		"POST /ecc/v1/regions/ir-thr-c2/float-ips/ip1/detach ",
+		"DELETE /ecc/v1/regions/ir-thr-c2/float-ips/ip1 ",
+	}
+	if !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+}
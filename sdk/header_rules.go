@@ -0,0 +1,269 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HeaderRulePhase is whether a HeaderRule rewrites the request to the origin or the response to the visitor
+type HeaderRulePhase string
+
+// HeaderRulePhase enums
+const (
+	HeaderRulePhaseRequest  HeaderRulePhase = "request"
+	HeaderRulePhaseResponse HeaderRulePhase = "response"
+)
+
+// HeaderOperation is how a HeaderAction changes a header
+type HeaderOperation string
+
+// HeaderOperation enums
+const (
+	// HeaderOperationAdd appends a value, keeping existing values of the header
+	HeaderOperationAdd HeaderOperation = "add"
+	// HeaderOperationSet replaces all values of the header
+	HeaderOperationSet HeaderOperation = "set"
+	// HeaderOperationRemove removes the header, Value is ignored
+	HeaderOperationRemove HeaderOperation = "remove"
+)
+
+// HeaderAction is a single header change applied by a HeaderRule
+type HeaderAction struct {
+	Operation HeaderOperation `json:"operation"`
+	Name      string          `json:"name"`
+	Value     string          `json:"value,omitempty"`
+}
+
+// HeaderRule rewrites the headers of requests or responses matching a URL pattern
+type HeaderRule struct {
+	ID         string          `json:"id"`
+	URLPattern string          `json:"url_pattern"`
+	Phase      HeaderRulePhase `json:"phase"`
+	Actions    []HeaderAction  `json:"actions"`
+	Priority   int             `json:"priority"`
+	Enabled    bool            `json:"enabled"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+// HeaderRuleCreateOptions fields are those accepted by CreateHeaderRule
+type HeaderRuleCreateOptions struct {
+	URLPattern string          `json:"url_pattern"`
+	Phase      HeaderRulePhase `json:"phase"`
+	Actions    []HeaderAction  `json:"actions"`
+	Enabled    *bool           `json:"enabled,omitempty"`
+}
+
+// HeaderRuleUpdateOptions fields are those accepted by UpdateHeaderRule
+type HeaderRuleUpdateOptions struct {
+	URLPattern string          `json:"url_pattern,omitempty"`
+	Phase      HeaderRulePhase `json:"phase,omitempty"`
+	Actions    []HeaderAction  `json:"actions,omitempty"`
+	Enabled    *bool           `json:"enabled,omitempty"`
+}
+
+// GetUpdateOptions converts a HeaderRule to HeaderRuleUpdateOptions for use in UpdateHeaderRule
+func (r HeaderRule) GetUpdateOptions() HeaderRuleUpdateOptions {
+	enabled := r.Enabled
+
+	return HeaderRuleUpdateOptions{
+		URLPattern: r.URLPattern,
+		Phase:      r.Phase,
+		Actions:    r.Actions,
+		Enabled:    &enabled,
+	}
+}
+
+// SecurityHeaders describes common security response headers. Empty fields are left out.
+type SecurityHeaders struct {
+	// HSTSMaxAge is the Strict-Transport-Security max-age in seconds
+	HSTSMaxAge            int
+	HSTSIncludeSubdomains bool
+	HSTSPreload           bool
+	ContentSecurityPolicy string
+	// FrameOptions is the X-Frame-Options value, e.g. "DENY" or "SAMEORIGIN"
+	FrameOptions   string
+	NoSniff        bool
+	ReferrerPolicy string
+}
+
+// Actions returns the HeaderActions setting the headers, for use in a HeaderRulePhaseResponse rule
+func (s SecurityHeaders) Actions() []HeaderAction {
+	var actions []HeaderAction
+
+	if s.HSTSMaxAge > 0 {
+		value := fmt.Sprintf("max-age=%d", s.HSTSMaxAge)
+		if s.HSTSIncludeSubdomains {
+			value += "; includeSubDomains"
+		}
+		if s.HSTSPreload {
+			value += "; preload"
+		}
+		actions = append(actions, HeaderAction{Operation: HeaderOperationSet, Name: "Strict-Transport-Security", Value: value})
+	}
+
+	if s.ContentSecurityPolicy != "" {
+		actions = append(actions, HeaderAction{Operation: HeaderOperationSet, Name: "Content-Security-Policy", Value: s.ContentSecurityPolicy})
+	}
+
+	if s.FrameOptions != "" {
+		actions = append(actions, HeaderAction{Operation: HeaderOperationSet, Name: "X-Frame-Options", Value: s.FrameOptions})
+	}
+
+	if s.NoSniff {
+		actions = append(actions, HeaderAction{Operation: HeaderOperationSet, Name: "X-Content-Type-Options", Value: "nosniff"})
+	}
+
+	if s.ReferrerPolicy != "" {
+		actions = append(actions, HeaderAction{Operation: HeaderOperationSet, Name: "Referrer-Policy", Value: s.ReferrerPolicy})
+	}
+
+	return actions
+}
+
+// CORSHeaders describes the CORS response headers of a resource. Empty fields are left out.
+type CORSHeaders struct {
+	AllowOrigin      string
+	AllowMethods     []string
+	AllowHeaders     []string
+	ExposeHeaders    []string
+	AllowCredentials bool
+	// MaxAge is how long, in seconds, preflight results may be cached
+	MaxAge int
+}
+
+// Actions returns the HeaderActions setting the headers, for use in a HeaderRulePhaseResponse rule
+func (c CORSHeaders) Actions() []HeaderAction {
+	var actions []HeaderAction
+
+	set := func(name, value string) {
+		if value != "" {
+			actions = append(actions, HeaderAction{Operation: HeaderOperationSet, Name: name, Value: value})
+		}
+	}
+
+	set("Access-Control-Allow-Origin", c.AllowOrigin)
+	set("Access-Control-Allow-Methods", strings.Join(c.AllowMethods, ", "))
+	set("Access-Control-Allow-Headers", strings.Join(c.AllowHeaders, ", "))
+	set("Access-Control-Expose-Headers", strings.Join(c.ExposeHeaders, ", "))
+
+	if c.AllowCredentials {
+		set("Access-Control-Allow-Credentials", "true")
+	}
+
+	if c.MaxAge > 0 {
+		set("Access-Control-Max-Age", strconv.Itoa(c.MaxAge))
+	}
+
+	return actions
+}
+
+// HeaderRulesPagedResponse represents a paginated HeaderRule API response
+type HeaderRulesPagedResponse struct {
+	*PageOptions
+	Data []HeaderRule `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for HeaderRules of a Domain
+func (HeaderRulesPagedResponse) endpointWithID(c *Client, domain string) string {
+	endpoint, err := c.HeaderRules.endpointWithParams(domain)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends HeaderRules when processing paginated HeaderRule responses
+func (resp *HeaderRulesPagedResponse) appendData(r *HeaderRulesPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListHeaderRules lists the HeaderRules of a Domain in evaluation order
+func (c *Client) ListHeaderRules(ctx context.Context, domain string, opts *ListOptions) ([]HeaderRule, error) {
+	response := HeaderRulesPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, domain, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetHeaderRule gets a HeaderRule of a Domain
+func (c *Client) GetHeaderRule(ctx context.Context, domain, ruleID string) (*HeaderRule, error) {
+	e, err := c.HeaderRules.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	rule := &HeaderRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// CreateHeaderRule creates a HeaderRule on a Domain. New rules are evaluated last.
+func (c *Client) CreateHeaderRule(ctx context.Context, domain string, createOpts HeaderRuleCreateOptions) (*HeaderRule, error) {
+	e, err := c.HeaderRules.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	rule := &HeaderRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// UpdateHeaderRule updates a HeaderRule of a Domain
+func (c *Client) UpdateHeaderRule(ctx context.Context, domain, ruleID string, updateOpts HeaderRuleUpdateOptions) (*HeaderRule, error) {
+	e, err := c.HeaderRules.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	rule := &HeaderRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// DeleteHeaderRule deletes a HeaderRule of a Domain
+func (c *Client) DeleteHeaderRule(ctx context.Context, domain, ruleID string) error {
+	e, err := c.HeaderRules.endpointWithParams(domain)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
+
+// ReorderHeaderRules sets the evaluation order of all HeaderRules of a Domain in one call
+func (c *Client) ReorderHeaderRules(ctx context.Context, domain string, ruleIDs []string) error {
+	return c.reorderRules(ctx, c.HeaderRules, domain, ruleIDs)
+}
@@ -0,0 +1,42 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSecurityHeaders_Actions(t *testing.T) {
+	actions := SecurityHeaders{
+		HSTSMaxAge:            31536000,
+		HSTSIncludeSubdomains: true,
+		ContentSecurityPolicy: "default-src 'self'",
+		FrameOptions:          "DENY",
+	}.Actions()
+
+	expected := []HeaderAction{
+		{Operation: HeaderOperationSet, Name: "Strict-Transport-Security", Value: "max-age=31536000; includeSubDomains"},
+		{Operation: HeaderOperationSet, Name: "Content-Security-Policy", Value: "default-src 'self'"},
+		{Operation: HeaderOperationSet, Name: "X-Frame-Options", Value: "DENY"},
+	}
+	if !cmp.Equal(actions, expected) {
+		t.Error(cmp.Diff(actions, expected))
+	}
+}
+
+func TestCORSHeaders_Actions(t *testing.T) {
+	actions := CORSHeaders{
+		AllowOrigin:  "https://example.com",
+		AllowMethods: []string{"GET", "POST"},
+		MaxAge:       600,
+	}.Actions()
+
+	expected := []HeaderAction{
+		{Operation: HeaderOperationSet, Name: "Access-Control-Allow-Origin", Value: "https://example.com"},
+		{Operation: HeaderOperationSet, Name: "Access-Control-Allow-Methods", Value: "GET, POST"},
+		{Operation: HeaderOperationSet, Name: "Access-Control-Max-Age", Value: "600"},
+	}
+	if !cmp.Equal(actions, expected) {
+		t.Error(cmp.Diff(actions, expected))
+	}
+}
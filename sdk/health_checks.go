@@ -0,0 +1,219 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// HealthCheckProtocol is the protocol a HealthCheck probes origins with
+type HealthCheckProtocol string
+
+// HealthCheckProtocol enums
+const (
+	HealthCheckProtocolHTTP  HealthCheckProtocol = "http"
+	HealthCheckProtocolHTTPS HealthCheckProtocol = "https"
+	HealthCheckProtocolTCP   HealthCheckProtocol = "tcp"
+)
+
+// HealthCheck is a monitor probing the origins of the LoadBalancerPools it is attached to.
+// Available when Domain.Features.UseHealthCheck is set.
+type HealthCheck struct {
+	ID       string              `json:"id"`
+	Name     string              `json:"name"`
+	Protocol HealthCheckProtocol `json:"protocol"`
+	Port     int                 `json:"port"`
+	Path     string              `json:"path"`
+	// ExpectedStatus is the HTTP status code of a healthy response
+	ExpectedStatus int `json:"expected_status"`
+	// Interval is the time between probes, in seconds
+	Interval int `json:"interval"`
+	// Timeout is how long a probe may take, in seconds
+	Timeout int `json:"timeout"`
+	// Retries is the number of failed probes before an origin is marked unhealthy
+	Retries   int               `json:"retries"`
+	Headers   map[string]string `json:"headers"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// HealthCheckCreateOptions fields are those accepted by CreateHealthCheck
+type HealthCheckCreateOptions struct {
+	Name           string              `json:"name"`
+	Protocol       HealthCheckProtocol `json:"protocol"`
+	Port           int                 `json:"port,omitempty"`
+	Path           string              `json:"path,omitempty"`
+	ExpectedStatus int                 `json:"expected_status,omitempty"`
+	Interval       int                 `json:"interval,omitempty"`
+	Timeout        int                 `json:"timeout,omitempty"`
+	Retries        int                 `json:"retries,omitempty"`
+	Headers        map[string]string   `json:"headers,omitempty"`
+}
+
+// HealthCheckUpdateOptions fields are those accepted by UpdateHealthCheck.
+// Empty fields are left unchanged.
+type HealthCheckUpdateOptions struct {
+	Name           string              `json:"name,omitempty"`
+	Protocol       HealthCheckProtocol `json:"protocol,omitempty"`
+	Port           int                 `json:"port,omitempty"`
+	Path           string              `json:"path,omitempty"`
+	ExpectedStatus int                 `json:"expected_status,omitempty"`
+	Interval       int                 `json:"interval,omitempty"`
+	Timeout        int                 `json:"timeout,omitempty"`
+	// Retries is left unchanged when nil, point to 0 to fail on the first unhealthy probe
+	Retries *int `json:"retries,omitempty"`
+	// Headers are left unchanged when nil, point to an empty map to clear them
+	Headers *map[string]string `json:"headers,omitempty"`
+}
+
+// GetUpdateOptions converts a HealthCheck to HealthCheckUpdateOptions for use in UpdateHealthCheck
+func (h HealthCheck) GetUpdateOptions() HealthCheckUpdateOptions {
+	retries := h.Retries
+	headers := map[string]string{}
+	for k, v := range h.Headers {
+		headers[k] = v
+	}
+
+	return HealthCheckUpdateOptions{
+		Name:           h.Name,
+		Protocol:       h.Protocol,
+		Port:           h.Port,
+		Path:           h.Path,
+		ExpectedStatus: h.ExpectedStatus,
+		Interval:       h.Interval,
+		Timeout:        h.Timeout,
+		Retries:        &retries,
+		Headers:        &headers,
+	}
+}
+
+// OriginHealth is the current result of a HealthCheck for one LoadBalancerOrigin
+type OriginHealth struct {
+	OriginID string `json:"origin_id"`
+	PoolID   string `json:"pool_id"`
+	Address  string `json:"address"`
+	Healthy  bool   `json:"healthy"`
+	// ResponseTime of the last probe, in milliseconds
+	ResponseTime  int       `json:"response_time"`
+	FailureReason string    `json:"failure_reason"`
+	LastCheckedAt time.Time `json:"last_checked_at"`
+}
+
+// HealthChecksPagedResponse represents a paginated HealthCheck API response
+type HealthChecksPagedResponse struct {
+	*PageOptions
+	Data []HealthCheck `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for HealthChecks of a Domain
+func (HealthChecksPagedResponse) endpointWithID(c *Client, domain string) string {
+	endpoint, err := c.HealthChecks.endpointWithParams(domain)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends HealthChecks when processing paginated HealthCheck responses
+func (resp *HealthChecksPagedResponse) appendData(r *HealthChecksPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListHealthChecks lists the HealthChecks of a Domain
+func (c *Client) ListHealthChecks(ctx context.Context, domain string, opts *ListOptions) ([]HealthCheck, error) {
+	response := HealthChecksPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, domain, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetHealthCheck gets a HealthCheck of a Domain
+func (c *Client) GetHealthCheck(ctx context.Context, domain, healthCheckID string) (*HealthCheck, error) {
+	e, err := c.HealthChecks.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, healthCheckID)
+
+	check := &HealthCheck{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(check)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return check, nil
+}
+
+// CreateHealthCheck creates a HealthCheck on a Domain
+func (c *Client) CreateHealthCheck(ctx context.Context, domain string, createOpts HealthCheckCreateOptions) (*HealthCheck, error) {
+	e, err := c.HealthChecks.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	check := &HealthCheck{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(check)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return check, nil
+}
+
+// UpdateHealthCheck updates a HealthCheck of a Domain
+func (c *Client) UpdateHealthCheck(ctx context.Context, domain, healthCheckID string, updateOpts HealthCheckUpdateOptions) (*HealthCheck, error) {
+	e, err := c.HealthChecks.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, healthCheckID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	check := &HealthCheck{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(check)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return check, nil
+}
+
+// DeleteHealthCheck deletes a HealthCheck of a Domain
+func (c *Client) DeleteHealthCheck(ctx context.Context, domain, healthCheckID string) error {
+	e, err := c.HealthChecks.endpointWithParams(domain)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, healthCheckID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
+
+// GetLoadBalancerPoolHealth gets the current health of every origin of a LoadBalancerPool,
+// as reported by the HealthCheck attached to the pool
+func (c *Client) GetLoadBalancerPoolHealth(ctx context.Context, domain, poolID string) ([]OriginHealth, error) {
+	e, err := c.LoadBalancerPools.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s/health", e, poolID)
+
+	health := []OriginHealth{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(&health)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return health, nil
+}
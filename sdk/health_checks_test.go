@@ -0,0 +1,135 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHealthChecks(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/cdn/4.0/domains/example.com/health-checks":
+			writeJSON(w, http.StatusOK, `{"data":[{"id":"h1","name":"web","protocol":"https","path":"/healthz"}]}`)
+		case r.Method == http.MethodDelete:
+			writeJSON(w, http.StatusOK, `{"message":"ok"}`)
+		default:
+			writeJSON(w, http.StatusOK, `{"data":{"id":"h1","name":"web","protocol":"https","path":"/healthz","interval":30,"headers":{"Host":"example.com"}}}`)
+		}
+	})
+
+	ctx := context.Background()
+
+	check, err := client.CreateHealthCheck(ctx, "example.com", HealthCheckCreateOptions{
+		Name:     "web",
+		Protocol: HealthCheckProtocolHTTPS,
+		Path:     "/healthz",
+		Headers:  map[string]string{"Host": "example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Error creating health check: %s", err)
+	}
+
+	checks, err := client.ListHealthChecks(ctx, "example.com", nil)
+	if err != nil {
+		t.Fatalf("Error listing health checks: %s", err)
+	}
+
+	if len(checks) != 1 || checks[0].ID != check.ID {
+		t.Errorf("unexpected health checks %#v", checks)
+	}
+
+	// A partial update leaves the headers alone
+	check, err = client.UpdateHealthCheck(ctx, "example.com", check.ID, HealthCheckUpdateOptions{Interval: 30})
+	if err != nil {
+		t.Fatalf("Error updating health check: %s", err)
+	}
+
+	if !cmp.Equal(check.Headers, map[string]string{"Host": "example.com"}) {
+		t.Errorf("unexpected headers %v", check.Headers)
+	}
+
+	if err := client.DeleteHealthCheck(ctx, "example.com", check.ID); err != nil {
+		t.Fatalf("Error deleting health check: %s", err)
+	}
+
+	expected := []string{
+		`POST /cdn/4.0/domains/example.com/health-checks {"name":"web","protocol":"https","path":"/healthz","headers":{"Host":"example.com"}}`,
+		"GET /cdn/4.0/domains/example.com/health-checks ",
+		`PUT /cdn/4.0/domains/example.com/health-checks/h1 {"interval":30}`,
+		"DELETE /cdn/4.0/domains/example.com/health-checks/h1 ",
+	}
+	if !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+}
+
+func TestUpdateHealthCheck_clear(t *testing.T) {
+	var bodies []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		writeJSON(w, http.StatusOK, `{"data":{"id":"h1","name":"web","protocol":"https","retries":0}}`)
+	})
+
+	check := HealthCheck{ID: "h1", Name: "web", Protocol: HealthCheckProtocolHTTPS, Retries: 3, Headers: map[string]string{"Host": "example.com"}}
+
+	updateOpts := check.GetUpdateOptions()
+	*updateOpts.Retries = 0
+	delete(*updateOpts.Headers, "Host")
+	if _, err := client.UpdateHealthCheck(context.Background(), "example.com", check.ID, updateOpts); err != nil {
+		t.Fatalf("Error updating health check: %s", err)
+	}
+
+	if check.Headers["Host"] != "example.com" {
+		t.Error("expected GetUpdateOptions to copy the headers")
+	}
+
+	retries := 0
+	noHeaders := map[string]string{}
+	if _, err := client.UpdateHealthCheck(context.Background(), "example.com", check.ID, HealthCheckUpdateOptions{Retries: &retries, Headers: &noHeaders}); err != nil {
+		t.Fatalf("Error updating health check: %s", err)
+	}
+
+	expected := []string{
+		`{"name":"web","protocol":"https","retries":0,"headers":{}}`,
+		`{"retries":0,"headers":{}}`,
+	}
+	if !cmp.Equal(bodies, expected) {
+		t.Error(cmp.Diff(bodies, expected))
+	}
+}
+
+func TestGetLoadBalancerPoolHealth(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cdn/4.0/domains/example.com/load-balancers/pools/web/health" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		writeJSON(w, http.StatusOK, `{"data":[{"origin_id":"a","pool_id":"web","healthy":true,"response_time":42},{"origin_id":"b","pool_id":"web","failure_reason":"timeout"}]}`)
+	})
+
+	health, err := client.GetLoadBalancerPoolHealth(context.Background(), "example.com", "web")
+	if err != nil {
+		t.Fatalf("Error getting pool health: %s", err)
+	}
+
+	expected := []OriginHealth{
+		{OriginID: "a", PoolID: "web", Healthy: true, ResponseTime: 42},
+		{OriginID: "b", PoolID: "web", FailureReason: "timeout"},
+	}
+	if !cmp.Equal(health, expected) {
+		t.Error(cmp.Diff(health, expected))
+	}
+}
@@ -0,0 +1,108 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+)
+
+// Image is an operating system image, or a snapshot, servers are built from
+type Image struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Distribution string `json:"distribution"`
+	Version      string `json:"version"`
+	// MinDisk is the smallest disk, in GB, the image fits in
+	MinDisk int    `json:"min_disk"`
+	Status  string `json:"status"`
+}
+
+// Flavor is a server size, its CPU, memory and disk
+type Flavor struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	CPUCount int    `json:"cpu_count"`
+	// Memory is in MB
+	Memory int `json:"memory"`
+	// Disk is in GB
+	Disk int `json:"disk"`
+}
+
+// ImagesPagedResponse represents a paginated Image API response
+type ImagesPagedResponse struct {
+	*PageOptions
+	Data []Image `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for Images of a region
+func (ImagesPagedResponse) endpointWithID(c *Client, region string) string {
+	endpoint, err := c.Images.endpointWithParams(region)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends Images when processing paginated Image responses
+func (resp *ImagesPagedResponse) appendData(r *ImagesPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// FlavorsPagedResponse represents a paginated Flavor API response
+type FlavorsPagedResponse struct {
+	*PageOptions
+	Data []Flavor `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for Flavors of a region
+func (FlavorsPagedResponse) endpointWithID(c *Client, region string) string {
+	endpoint, err := c.Flavors.endpointWithParams(region)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends Flavors when processing paginated Flavor responses
+func (resp *FlavorsPagedResponse) appendData(r *FlavorsPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListImages lists the Images available in a region
+func (c *Client) ListImages(ctx context.Context, region string, opts *ListOptions) ([]Image, error) {
+	response := ImagesPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, region, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetImage gets an Image of a region
+func (c *Client) GetImage(ctx context.Context, region, imageID string) (*Image, error) {
+	e, err := c.Images.endpointWithParams(region)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, imageID)
+
+	image := &Image{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(image)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return image, nil
+}
+
+// ListFlavors lists the Flavors servers of a region can be created with
+func (c *Client) ListFlavors(ctx context.Context, region string, opts *ListOptions) ([]Flavor, error) {
+	response := FlavorsPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, region, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
@@ -0,0 +1,120 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListImages(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, fmt.Sprintf("%s %s?%s", r.Method, r.URL.Path, r.URL.RawQuery))
+
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			page = "1"
+		}
+		writeJSON(w, http.StatusOK, fmt.Sprintf(`{"data":[{"id":"i%s","name":"debian","distribution":"debian","version":"1%s","min_disk":25,"status":"active"}],"meta":{"current_page":%s,"last_page":2,"total":2}}`, page, page, page))
+	})
+
+	images, err := client.ListImages(context.Background(), "ir-thr-c2", nil)
+	if err != nil {
+		t.Fatalf("Error listing images: %s", err)
+	}
+
+	expected := []Image{
+		{ID: "i1", Name: "debian", Distribution: "debian", Version: "11", MinDisk: 25, Status: "active"},
+		{ID: "i2", Name: "debian", Distribution: "debian", Version: "12", MinDisk: 25, Status: "active"},
+	}
+	if !cmp.Equal(images, expected) {
+		t.Error(cmp.Diff(images, expected))
+	}
+
+	expectedRequests := []string{"GET /ecc/v1/regions/ir-thr-c2/images?", "GET /ecc/v1/regions/ir-thr-c2/images?page=2"}
+	if !cmp.Equal(requests, expectedRequests) {
+		t.Error(cmp.Diff(requests, expectedRequests))
+	}
+}
+
+func TestListImages_page(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, fmt.Sprintf("%s %s?%s", r.Method, r.URL.Path, r.URL.RawQuery))
+
+		writeJSON(w, http.StatusOK, `{"data":[{"id":"i2","name":"debian"}],"meta":{"current_page":2,"last_page":3,"total":3}}`)
+	})
+
+	opts := NewListOptions(2)
+	images, err := client.ListImages(context.Background(), "ir-thr-c2", opts)
+	if err != nil {
+		t.Fatalf("Error listing images: %s", err)
+	}
+
+	expected := []Image{{ID: "i2", Name: "debian"}}
+	if !cmp.Equal(images, expected) {
+		t.Error(cmp.Diff(images, expected))
+	}
+
+	expectedRequests := []string{"GET /ecc/v1/regions/ir-thr-c2/images?page=2"}
+	if !cmp.Equal(requests, expectedRequests) {
+		t.Error(cmp.Diff(requests, expectedRequests))
+	}
+}
+
+func TestGetImage(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/ecc/v1/regions/ir-thr-c2/images/i1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		writeJSON(w, http.StatusOK, `{"data":{"id":"i1","name":"ubuntu","distribution":"ubuntu","version":"22.04","min_disk":20,"status":"active"}}`)
+	})
+
+	image, err := client.GetImage(context.Background(), "ir-thr-c2", "i1")
+	if err != nil {
+		t.Fatalf("Error getting image: %s", err)
+	}
+
+	expected := &Image{ID: "i1", Name: "ubuntu", Distribution: "ubuntu", Version: "22.04", MinDisk: 20, Status: "active"}
+	if !cmp.Equal(image, expected) {
+		t.Error(cmp.Diff(image, expected))
+	}
+}
+
+func TestListFlavors(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, fmt.Sprintf("%s %s?%s", r.Method, r.URL.Path, r.URL.RawQuery))
+
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			page = "1"
+		}
+		writeJSON(w, http.StatusOK, fmt.Sprintf(`{"data":[{"id":"f%s","name":"g1-%s-1","cpu_count":%s,"memory":1024,"disk":25}],"meta":{"current_page":%s,"last_page":2,"total":2}}`, page, page, page, page))
+	})
+
+	flavors, err := client.ListFlavors(context.Background(), "ir-thr-c2", nil)
+	if err != nil {
+		t.Fatalf("Error listing flavors: %s", err)
+	}
+
+	expected := []Flavor{
+		{ID: "f1", Name: "g1-1-1", CPUCount: 1, Memory: 1024, Disk: 25},
+		{ID: "f2", Name: "g1-2-1", CPUCount: 2, Memory: 1024, Disk: 25},
+	}
+	if !cmp.Equal(flavors, expected) {
+		t.Error(cmp.Diff(flavors, expected))
+	}
+
+	expectedRequests := []string{"GET /ecc/v1/regions/ir-thr-c2/sizes?", "GET /ecc/v1/regions/ir-thr-c2/sizes?page=2"}
+	if !cmp.Equal(requests, expectedRequests) {
+		t.Error(cmp.Diff(requests, expectedRequests))
+	}
+}
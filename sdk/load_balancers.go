@@ -0,0 +1,360 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// LoadBalancerSteering is how a LoadBalancerPool spreads requests over its origins
+type LoadBalancerSteering string
+
+// LoadBalancerSteering enums
+const (
+	LoadBalancerSteeringRoundRobin LoadBalancerSteering = "round_robin"
+	LoadBalancerSteeringGeo        LoadBalancerSteering = "geo"
+	LoadBalancerSteeringFailover   LoadBalancerSteering = "failover"
+)
+
+// LoadBalancerAffinityType is what a LoadBalancerPool pins visitors to an origin by
+type LoadBalancerAffinityType string
+
+// LoadBalancerAffinityType enums
+const (
+	LoadBalancerAffinityCookie LoadBalancerAffinityType = "cookie"
+	LoadBalancerAffinityIP     LoadBalancerAffinityType = "ip"
+)
+
+// LoadBalancerSessionAffinity keeps sending a visitor to the same origin
+type LoadBalancerSessionAffinity struct {
+	Enabled bool                     `json:"enabled"`
+	Type    LoadBalancerAffinityType `json:"type,omitempty"`
+	// TTL is how long, in seconds, a visitor stays pinned to an origin
+	TTL int `json:"ttl,omitempty"`
+}
+
+// LoadBalancerOrigin is an upstream server of a LoadBalancerPool
+type LoadBalancerOrigin struct {
+	ID      string `json:"id"`
+	Address string `json:"address"`
+	Port    int    `json:"port"`
+	// Weight is the origin's share of requests under round robin steering
+	Weight int `json:"weight"`
+	// Priority orders origins under failover steering, lowest first
+	Priority int `json:"priority"`
+	// Region is the visitor region served by the origin under geo steering
+	Region  string `json:"region"`
+	Enabled bool   `json:"enabled"`
+}
+
+// LoadBalancerOriginCreateOptions fields are those accepted by CreateLoadBalancerOrigin
+type LoadBalancerOriginCreateOptions struct {
+	Address  string `json:"address"`
+	Port     int    `json:"port,omitempty"`
+	Weight   int    `json:"weight,omitempty"`
+	Priority int    `json:"priority,omitempty"`
+	Region   string `json:"region,omitempty"`
+	Enabled  *bool  `json:"enabled,omitempty"`
+}
+
+// LoadBalancerOriginUpdateOptions fields are those accepted by UpdateLoadBalancerOrigin
+type LoadBalancerOriginUpdateOptions struct {
+	Address  string `json:"address,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	Weight   int    `json:"weight,omitempty"`
+	Priority int    `json:"priority,omitempty"`
+	Region   string `json:"region,omitempty"`
+	Enabled  *bool  `json:"enabled,omitempty"`
+}
+
+// GetUpdateOptions converts a LoadBalancerOrigin to LoadBalancerOriginUpdateOptions for use in UpdateLoadBalancerOrigin
+func (o LoadBalancerOrigin) GetUpdateOptions() LoadBalancerOriginUpdateOptions {
+	enabled := o.Enabled
+
+	return LoadBalancerOriginUpdateOptions{
+		Address:  o.Address,
+		Port:     o.Port,
+		Weight:   o.Weight,
+		Priority: o.Priority,
+		Region:   o.Region,
+		Enabled:  &enabled,
+	}
+}
+
+// LoadBalancerOriginState is the desired state of one origin in SetLoadBalancerOriginStates
+type LoadBalancerOriginState struct {
+	ID      string `json:"id"`
+	Enabled bool   `json:"enabled"`
+}
+
+// LoadBalancerPool is a group of origins a Domain's traffic is balanced over.
+// Available when Domain.Features.UseNewLoadBalancer is set.
+type LoadBalancerPool struct {
+	ID              string                      `json:"id"`
+	Name            string                      `json:"name"`
+	Steering        LoadBalancerSteering        `json:"steering"`
+	SessionAffinity LoadBalancerSessionAffinity `json:"session_affinity"`
+	Origins         []LoadBalancerOrigin        `json:"origins"`
+	// HealthCheckID is the HealthCheck monitoring the pool's origins, if any
+	HealthCheckID string    `json:"health_check_id"`
+	Enabled       bool      `json:"enabled"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// LoadBalancerPoolCreateOptions fields are those accepted by CreateLoadBalancerPool
+type LoadBalancerPoolCreateOptions struct {
+	Name            string                            `json:"name"`
+	Steering        LoadBalancerSteering              `json:"steering"`
+	SessionAffinity *LoadBalancerSessionAffinity      `json:"session_affinity,omitempty"`
+	Origins         []LoadBalancerOriginCreateOptions `json:"origins,omitempty"`
+	HealthCheckID   string                            `json:"health_check_id,omitempty"`
+	Enabled         *bool                             `json:"enabled,omitempty"`
+}
+
+// LoadBalancerPoolUpdateOptions fields are those accepted by UpdateLoadBalancerPool.
+// Origins are managed through the LoadBalancerOrigin methods.
+type LoadBalancerPoolUpdateOptions struct {
+	Name            string                       `json:"name,omitempty"`
+	Steering        LoadBalancerSteering         `json:"steering,omitempty"`
+	SessionAffinity *LoadBalancerSessionAffinity `json:"session_affinity,omitempty"`
+	HealthCheckID   string                       `json:"health_check_id,omitempty"`
+	Enabled         *bool                        `json:"enabled,omitempty"`
+}
+
+// GetUpdateOptions converts a LoadBalancerPool to LoadBalancerPoolUpdateOptions for use in UpdateLoadBalancerPool
+func (p LoadBalancerPool) GetUpdateOptions() LoadBalancerPoolUpdateOptions {
+	affinity := p.SessionAffinity
+	enabled := p.Enabled
+
+	return LoadBalancerPoolUpdateOptions{
+		Name:            p.Name,
+		Steering:        p.Steering,
+		SessionAffinity: &affinity,
+		HealthCheckID:   p.HealthCheckID,
+		Enabled:         &enabled,
+	}
+}
+
+// LoadBalancerPoolsPagedResponse represents a paginated LoadBalancerPool API response
+type LoadBalancerPoolsPagedResponse struct {
+	*PageOptions
+	Data []LoadBalancerPool `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for LoadBalancerPools of a Domain
+func (LoadBalancerPoolsPagedResponse) endpointWithID(c *Client, domain string) string {
+	endpoint, err := c.LoadBalancerPools.endpointWithParams(domain)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends LoadBalancerPools when processing paginated LoadBalancerPool responses
+func (resp *LoadBalancerPoolsPagedResponse) appendData(r *LoadBalancerPoolsPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// LoadBalancerOriginsPagedResponse represents a paginated LoadBalancerOrigin API response
+type LoadBalancerOriginsPagedResponse struct {
+	*PageOptions
+	Data []LoadBalancerOrigin `json:"data"`
+}
+
+// endpointWithTwoIDs gets the endpoint URL for the LoadBalancerOrigins of a LoadBalancerPool
+func (LoadBalancerOriginsPagedResponse) endpointWithTwoIDs(c *Client, domain, poolID string) string {
+	endpoint, err := c.LoadBalancerOrigins.endpointWithParams(domain, poolID)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends LoadBalancerOrigins when processing paginated LoadBalancerOrigin responses
+func (resp *LoadBalancerOriginsPagedResponse) appendData(r *LoadBalancerOriginsPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListLoadBalancerPools lists the LoadBalancerPools of a Domain
+func (c *Client) ListLoadBalancerPools(ctx context.Context, domain string, opts *ListOptions) ([]LoadBalancerPool, error) {
+	response := LoadBalancerPoolsPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, domain, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetLoadBalancerPool gets a LoadBalancerPool of a Domain
+func (c *Client) GetLoadBalancerPool(ctx context.Context, domain, poolID string) (*LoadBalancerPool, error) {
+	e, err := c.LoadBalancerPools.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, poolID)
+
+	pool := &LoadBalancerPool{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(pool)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// CreateLoadBalancerPool creates a LoadBalancerPool on a Domain
+func (c *Client) CreateLoadBalancerPool(ctx context.Context, domain string, createOpts LoadBalancerPoolCreateOptions) (*LoadBalancerPool, error) {
+	e, err := c.LoadBalancerPools.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	pool := &LoadBalancerPool{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(pool)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// UpdateLoadBalancerPool updates a LoadBalancerPool of a Domain
+func (c *Client) UpdateLoadBalancerPool(ctx context.Context, domain, poolID string, updateOpts LoadBalancerPoolUpdateOptions) (*LoadBalancerPool, error) {
+	e, err := c.LoadBalancerPools.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, poolID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	pool := &LoadBalancerPool{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(pool)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// DeleteLoadBalancerPool deletes a LoadBalancerPool of a Domain
+func (c *Client) DeleteLoadBalancerPool(ctx context.Context, domain, poolID string) error {
+	e, err := c.LoadBalancerPools.endpointWithParams(domain)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, poolID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
+
+// ListLoadBalancerOrigins lists the LoadBalancerOrigins of a LoadBalancerPool
+func (c *Client) ListLoadBalancerOrigins(ctx context.Context, domain, poolID string, opts *ListOptions) ([]LoadBalancerOrigin, error) {
+	response := LoadBalancerOriginsPagedResponse{}
+	err := c.listHelperWithTwoIDs(ctx, &response, domain, poolID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateLoadBalancerOrigin adds a LoadBalancerOrigin to a LoadBalancerPool
+func (c *Client) CreateLoadBalancerOrigin(ctx context.Context, domain, poolID string, createOpts LoadBalancerOriginCreateOptions) (*LoadBalancerOrigin, error) {
+	e, err := c.LoadBalancerOrigins.endpointWithParams(domain, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	origin := &LoadBalancerOrigin{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(origin)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return origin, nil
+}
+
+// UpdateLoadBalancerOrigin updates a LoadBalancerOrigin of a LoadBalancerPool
+func (c *Client) UpdateLoadBalancerOrigin(ctx context.Context, domain, poolID, originID string, updateOpts LoadBalancerOriginUpdateOptions) (*LoadBalancerOrigin, error) {
+	e, err := c.LoadBalancerOrigins.endpointWithParams(domain, poolID)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, originID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	origin := &LoadBalancerOrigin{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(origin)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return origin, nil
+}
+
+// DeleteLoadBalancerOrigin removes a LoadBalancerOrigin from a LoadBalancerPool
+func (c *Client) DeleteLoadBalancerOrigin(ctx context.Context, domain, poolID, originID string) error {
+	e, err := c.LoadBalancerOrigins.endpointWithParams(domain, poolID)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, originID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
+
+// EnableLoadBalancerOrigin puts a LoadBalancerOrigin back into rotation
+func (c *Client) EnableLoadBalancerOrigin(ctx context.Context, domain, poolID, originID string) (*LoadBalancerOrigin, error) {
+	enabled := true
+	return c.UpdateLoadBalancerOrigin(ctx, domain, poolID, originID, LoadBalancerOriginUpdateOptions{Enabled: &enabled})
+}
+
+// DisableLoadBalancerOrigin takes a LoadBalancerOrigin out of rotation, e.g. to drain it during a deploy
+func (c *Client) DisableLoadBalancerOrigin(ctx context.Context, domain, poolID, originID string) (*LoadBalancerOrigin, error) {
+	enabled := false
+	return c.UpdateLoadBalancerOrigin(ctx, domain, poolID, originID, LoadBalancerOriginUpdateOptions{Enabled: &enabled})
+}
+
+// SetLoadBalancerOriginStates enables and disables several origins of a LoadBalancerPool
+// in a single request, so the pool never serves from a partially toggled set of origins.
+// Origins not listed in states keep their current state.
+func (c *Client) SetLoadBalancerOriginStates(ctx context.Context, domain, poolID string, states []LoadBalancerOriginState) ([]LoadBalancerOrigin, error) {
+	e, err := c.LoadBalancerOrigins.endpointWithParams(domain, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(struct {
+		Origins []LoadBalancerOriginState `json:"origins"`
+	}{states})
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	origins := []LoadBalancerOrigin{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(&origins)).SetBody(string(bodyData)).Patch(e)); err != nil {
+		return nil, err
+	}
+
+	return origins, nil
+}
@@ -0,0 +1,181 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSetLoadBalancerOriginStates(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/cdn/4.0/domains/example.com/load-balancers/pools/web/origins" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"origins":[{"id":"a","enabled":false},{"id":"b","enabled":true}]}`; string(body) != expected {
+			t.Errorf("expected body %s, got %s", expected, body)
+		}
+
+		writeJSON(w, http.StatusOK, `{"data":[{"id":"a","weight":50,"enabled":false},{"id":"b","weight":50,"enabled":true}]}`)
+	})
+
+	origins, err := client.SetLoadBalancerOriginStates(context.Background(), "example.com", "web", []LoadBalancerOriginState{
+		{ID: "a", Enabled: false},
+		{ID: "b", Enabled: true},
+	})
+	if err != nil {
+		t.Fatalf("Error setting origin states: %s", err)
+	}
+
+	expected := []LoadBalancerOrigin{{ID: "a", Weight: 50}, {ID: "b", Weight: 50, Enabled: true}}
+	if !cmp.Equal(origins, expected) {
+		t.Error(cmp.Diff(origins, expected))
+	}
+}
+
+func TestLoadBalancerPools(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/cdn/4.0/domains/example.com/load-balancers/pools":
+			writeJSON(w, http.StatusOK, `{"data":[{"id":"web","name":"web","steering":"round_robin","enabled":true}]}`)
+		case r.Method == http.MethodDelete:
+			writeJSON(w, http.StatusOK, `{"message":"ok"}`)
+		case r.Method == http.MethodPut:
+			writeJSON(w, http.StatusOK, `{"data":{"id":"web","name":"web","steering":"failover","session_affinity":{"enabled":true,"type":"cookie","ttl":3600},"enabled":true}}`)
+		default:
+			writeJSON(w, http.StatusOK, `{"data":{"id":"web","name":"web","steering":"round_robin","origins":[{"id":"a","address":"192.0.2.1","port":443,"weight":100,"enabled":true}],"enabled":true}}`)
+		}
+	})
+
+	ctx := context.Background()
+
+	pool, err := client.CreateLoadBalancerPool(ctx, "example.com", LoadBalancerPoolCreateOptions{
+		Name:     "web",
+		Steering: LoadBalancerSteeringRoundRobin,
+		Origins:  []LoadBalancerOriginCreateOptions{{Address: "192.0.2.1", Port: 443, Weight: 100}},
+	})
+	if err != nil {
+		t.Fatalf("Error creating load balancer pool: %s", err)
+	}
+
+	if len(pool.Origins) != 1 || pool.Origins[0].ID != "a" {
+		t.Errorf("unexpected pool %#v", pool)
+	}
+
+	pools, err := client.ListLoadBalancerPools(ctx, "example.com", nil)
+	if err != nil {
+		t.Fatalf("Error listing load balancer pools: %s", err)
+	}
+
+	if len(pools) != 1 || pools[0].ID != "web" {
+		t.Errorf("unexpected pools %#v", pools)
+	}
+
+	if _, err := client.GetLoadBalancerPool(ctx, "example.com", "web"); err != nil {
+		t.Fatalf("Error getting load balancer pool: %s", err)
+	}
+
+	pool, err = client.UpdateLoadBalancerPool(ctx, "example.com", "web", LoadBalancerPoolUpdateOptions{
+		Steering:        LoadBalancerSteeringFailover,
+		SessionAffinity: &LoadBalancerSessionAffinity{Enabled: true, Type: LoadBalancerAffinityCookie, TTL: 3600},
+	})
+	if err != nil {
+		t.Fatalf("Error updating load balancer pool: %s", err)
+	}
+
+	expectedAffinity := LoadBalancerSessionAffinity{Enabled: true, Type: LoadBalancerAffinityCookie, TTL: 3600}
+	if pool.Steering != LoadBalancerSteeringFailover || !cmp.Equal(pool.SessionAffinity, expectedAffinity) {
+		t.Errorf("unexpected pool %#v", pool)
+	}
+
+	if err := client.DeleteLoadBalancerPool(ctx, "example.com", "web"); err != nil {
+		t.Fatalf("Error deleting load balancer pool: %s", err)
+	}
+
+	expected := []string{
+		`POST /cdn/4.0/domains/example.com/load-balancers/pools {"name":"web","steering":"round_robin","origins":[{"address":"192.0.2.1","port":443,"weight":100}]}`,
+		"GET /cdn/4.0/domains/example.com/load-balancers/pools ",
+		"GET /cdn/4.0/domains/example.com/load-balancers/pools/web ",
+		`PUT /cdn/4.0/domains/example.com/load-balancers/pools/web {"steering":"failover","session_affinity":{"enabled":true,"type":"cookie","ttl":3600}}`,
+		"DELETE /cdn/4.0/domains/example.com/load-balancers/pools/web ",
+	}
+	if !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+}
+
+func TestLoadBalancerOrigins(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, `{"data":[{"id":"b","address":"192.0.2.2","port":443,"weight":50,"enabled":true}]}`)
+		case http.MethodDelete:
+			writeJSON(w, http.StatusOK, `{"message":"ok"}`)
+		default:
+			writeJSON(w, http.StatusOK, `{"data":{"id":"b","address":"192.0.2.2","port":443,"weight":50,"enabled":true}}`)
+		}
+	})
+
+	ctx := context.Background()
+
+	origin, err := client.CreateLoadBalancerOrigin(ctx, "example.com", "web", LoadBalancerOriginCreateOptions{
+		Address: "192.0.2.2",
+		Port:    443,
+		Weight:  50,
+	})
+	if err != nil {
+		t.Fatalf("Error creating load balancer origin: %s", err)
+	}
+
+	origins, err := client.ListLoadBalancerOrigins(ctx, "example.com", "web", nil)
+	if err != nil {
+		t.Fatalf("Error listing load balancer origins: %s", err)
+	}
+
+	if len(origins) != 1 || origins[0].ID != origin.ID {
+		t.Errorf("unexpected origins %#v", origins)
+	}
+
+	if _, err := client.UpdateLoadBalancerOrigin(ctx, "example.com", "web", origin.ID, LoadBalancerOriginUpdateOptions{Weight: 25}); err != nil {
+		t.Fatalf("Error updating load balancer origin: %s", err)
+	}
+
+	if _, err := client.DisableLoadBalancerOrigin(ctx, "example.com", "web", origin.ID); err != nil {
+		t.Fatalf("Error disabling load balancer origin: %s", err)
+	}
+
+	if _, err := client.EnableLoadBalancerOrigin(ctx, "example.com", "web", origin.ID); err != nil {
+		t.Fatalf("Error enabling load balancer origin: %s", err)
+	}
+
+	if err := client.DeleteLoadBalancerOrigin(ctx, "example.com", "web", origin.ID); err != nil {
+		t.Fatalf("Error deleting load balancer origin: %s", err)
+	}
+
+	expected := []string{
+		`POST /cdn/4.0/domains/example.com/load-balancers/pools/web/origins {"address":"192.0.2.2","port":443,"weight":50}`,
+		"GET /cdn/4.0/domains/example.com/load-balancers/pools/web/origins ",
+		`PUT /cdn/4.0/domains/example.com/load-balancers/pools/web/origins/b {"weight":25}`,
+		`PUT /cdn/4.0/domains/example.com/load-balancers/pools/web/origins/b {"enabled":false}`,
+		`PUT /cdn/4.0/domains/example.com/load-balancers/pools/web/origins/b {"enabled":true}`,
+		"DELETE /cdn/4.0/domains/example.com/load-balancers/pools/web/origins/b ",
+	}
+	if !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+}
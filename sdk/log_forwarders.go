@@ -0,0 +1,229 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// LogForwarderType is the kind of destination a LogForwarder ships logs to
+type LogForwarderType string
+
+// LogForwarderType enums
+const (
+	LogForwarderSyslog        LogForwarderType = "syslog"
+	LogForwarderElasticsearch LogForwarderType = "elasticsearch"
+	LogForwarderHTTP          LogForwarderType = "http"
+	LogForwarderKafka         LogForwarderType = "kafka"
+	LogForwarderS3            LogForwarderType = "s3"
+)
+
+// LogFormat is the format log lines are forwarded in
+type LogFormat string
+
+// LogFormat enums
+const (
+	LogFormatJSON LogFormat = "json"
+	LogFormatCSV  LogFormat = "csv"
+	LogFormatCEF  LogFormat = "cef"
+)
+
+// SyslogForwarderConfig is the destination of a LogForwarderSyslog
+type SyslogForwarderConfig struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+	// Protocol is one of "udp", "tcp" or "tls"
+	Protocol string `json:"protocol"`
+}
+
+// ElasticsearchForwarderConfig is the destination of a LogForwarderElasticsearch.
+// Authenticate with either Username and Password or APIKey.
+type ElasticsearchForwarderConfig struct {
+	URL      string `json:"url"`
+	Index    string `json:"index"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	APIKey   string `json:"api_key,omitempty"`
+}
+
+// HTTPForwarderConfig is the destination of a LogForwarderHTTP, such as a Splunk HTTP Event Collector
+type HTTPForwarderConfig struct {
+	URL     string            `json:"url"`
+	Token   string            `json:"token,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// KafkaForwarderConfig is the destination of a LogForwarderKafka
+type KafkaForwarderConfig struct {
+	Brokers []string `json:"brokers"`
+	Topic   string   `json:"topic"`
+	// SASLMechanism is one of "plain", "scram-sha-256" or "scram-sha-512", empty for no authentication
+	SASLMechanism string `json:"sasl_mechanism,omitempty"`
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	TLS           bool   `json:"tls"`
+}
+
+// S3ForwarderConfig is the destination of a LogForwarderS3, any S3-compatible storage
+type S3ForwarderConfig struct {
+	Endpoint  string `json:"endpoint"`
+	Region    string `json:"region,omitempty"`
+	Bucket    string `json:"bucket"`
+	Prefix    string `json:"prefix,omitempty"`
+	AccessKey string `json:"access_key"`
+	SecretKey string `json:"secret_key,omitempty"`
+}
+
+// LogForwarderConfig holds the destination of a LogForwarder. Only the field
+// matching the forwarder's Type is set. Secrets are masked when read back.
+type LogForwarderConfig struct {
+	Syslog        *SyslogForwarderConfig        `json:"syslog,omitempty"`
+	Elasticsearch *ElasticsearchForwarderConfig `json:"elasticsearch,omitempty"`
+	HTTP          *HTTPForwarderConfig          `json:"http,omitempty"`
+	Kafka         *KafkaForwarderConfig         `json:"kafka,omitempty"`
+	S3            *S3ForwarderConfig            `json:"s3,omitempty"`
+}
+
+// LogForwarder ships the edge logs of a Domain to an external destination
+type LogForwarder struct {
+	ID        string             `json:"id"`
+	Name      string             `json:"name"`
+	Type      LogForwarderType   `json:"type"`
+	Format    LogFormat          `json:"format"`
+	Config    LogForwarderConfig `json:"config"`
+	Enabled   bool               `json:"enabled"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// LogForwarderCreateOptions fields are those accepted by CreateLogForwarder
+type LogForwarderCreateOptions struct {
+	Name    string             `json:"name"`
+	Type    LogForwarderType   `json:"type"`
+	Format  LogFormat          `json:"format"`
+	Config  LogForwarderConfig `json:"config"`
+	Enabled *bool              `json:"enabled,omitempty"`
+}
+
+// LogForwarderUpdateOptions fields are those accepted by UpdateLogForwarder.
+// The Type of a LogForwarder cannot be changed.
+type LogForwarderUpdateOptions struct {
+	Name    string              `json:"name,omitempty"`
+	Format  LogFormat           `json:"format,omitempty"`
+	Config  *LogForwarderConfig `json:"config,omitempty"`
+	Enabled *bool               `json:"enabled,omitempty"`
+}
+
+// GetUpdateOptions converts a LogForwarder to LogForwarderUpdateOptions for use in UpdateLogForwarder.
+// Config is left out as the secrets it was read back with are masked.
+func (f LogForwarder) GetUpdateOptions() LogForwarderUpdateOptions {
+	enabled := f.Enabled
+
+	return LogForwarderUpdateOptions{
+		Name:    f.Name,
+		Format:  f.Format,
+		Enabled: &enabled,
+	}
+}
+
+// LogForwardersPagedResponse represents a paginated LogForwarder API response
+type LogForwardersPagedResponse struct {
+	*PageOptions
+	Data []LogForwarder `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for LogForwarders of a Domain
+func (LogForwardersPagedResponse) endpointWithID(c *Client, domain string) string {
+	endpoint, err := c.LogForwarders.endpointWithParams(domain)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends LogForwarders when processing paginated LogForwarder responses
+func (resp *LogForwardersPagedResponse) appendData(r *LogForwardersPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListLogForwarders lists the LogForwarders of a Domain
+func (c *Client) ListLogForwarders(ctx context.Context, domain string, opts *ListOptions) ([]LogForwarder, error) {
+	response := LogForwardersPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, domain, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetLogForwarder gets a LogForwarder of a Domain
+func (c *Client) GetLogForwarder(ctx context.Context, domain, forwarderID string) (*LogForwarder, error) {
+	e, err := c.LogForwarders.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, forwarderID)
+
+	forwarder := &LogForwarder{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(forwarder)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return forwarder, nil
+}
+
+// CreateLogForwarder creates a LogForwarder on a Domain
+func (c *Client) CreateLogForwarder(ctx context.Context, domain string, createOpts LogForwarderCreateOptions) (*LogForwarder, error) {
+	e, err := c.LogForwarders.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	forwarder := &LogForwarder{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(forwarder)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return forwarder, nil
+}
+
+// UpdateLogForwarder updates a LogForwarder of a Domain
+func (c *Client) UpdateLogForwarder(ctx context.Context, domain, forwarderID string, updateOpts LogForwarderUpdateOptions) (*LogForwarder, error) {
+	e, err := c.LogForwarders.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, forwarderID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	forwarder := &LogForwarder{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(forwarder)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return forwarder, nil
+}
+
+// DeleteLogForwarder deletes a LogForwarder of a Domain
+func (c *Client) DeleteLogForwarder(ctx context.Context, domain, forwarderID string) error {
+	e, err := c.LogForwarders.endpointWithParams(domain)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, forwarderID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
@@ -0,0 +1,72 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLogForwarders(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		switch r.Method {
+		case http.MethodDelete:
+			writeJSON(w, http.StatusOK, `{"message":"ok"}`)
+		case http.MethodPut:
+			writeJSON(w, http.StatusOK, `{"data":{"id":"f1","name":"siem","type":"syslog","format":"cef","config":{"syslog":{"host":"logs.example.com","port":6514,"protocol":"tls"}},"enabled":false}}`)
+		default:
+			writeJSON(w, http.StatusCreated, `{"data":{"id":"f1","name":"siem","type":"syslog","format":"json","config":{"syslog":{"host":"logs.example.com","port":6514,"protocol":"tls"}},"enabled":true}}`)
+		}
+	})
+
+	ctx := context.Background()
+
+	forwarder, err := client.CreateLogForwarder(ctx, "example.com", LogForwarderCreateOptions{
+		Name:   "siem",
+		Type:   LogForwarderSyslog,
+		Format: LogFormatJSON,
+		Config: LogForwarderConfig{Syslog: &SyslogForwarderConfig{Host: "logs.example.com", Port: 6514, Protocol: "tls"}},
+	})
+	if err != nil {
+		t.Fatalf("Error creating log forwarder: %s", err)
+	}
+
+	expectedConfig := LogForwarderConfig{Syslog: &SyslogForwarderConfig{Host: "logs.example.com", Port: 6514, Protocol: "tls"}}
+	if !cmp.Equal(forwarder.Config, expectedConfig) {
+		t.Error(cmp.Diff(forwarder.Config, expectedConfig))
+	}
+
+	updateOpts := forwarder.GetUpdateOptions()
+	updateOpts.Format = LogFormatCEF
+	*updateOpts.Enabled = false
+
+	forwarder, err = client.UpdateLogForwarder(ctx, "example.com", forwarder.ID, updateOpts)
+	if err != nil {
+		t.Fatalf("Error updating log forwarder: %s", err)
+	}
+
+	if forwarder.Format != LogFormatCEF || forwarder.Enabled {
+		t.Errorf("unexpected log forwarder %#v", forwarder)
+	}
+
+	if err := client.DeleteLogForwarder(ctx, "example.com", forwarder.ID); err != nil {
+		t.Fatalf("Error deleting log forwarder: %s", err)
+	}
+
+	expected := []string{
+		`POST /cdn/4.0/domains/example.com/log-forwarders {"name":"siem","type":"syslog","format":"json","config":{"syslog":{"host":"logs.example.com","port":6514,"protocol":"tls"}}}`,
+		`PUT /cdn/4.0/domains/example.com/log-forwarders/f1 {"name":"siem","format":"cef","enabled":false}`,
+		"DELETE /cdn/4.0/domains/example.com/log-forwarders/f1 ",
+	}
+	if !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+}
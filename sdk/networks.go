@@ -0,0 +1,282 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Subnet is an address range of a private Network
+type Subnet struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// CIDR is the address range of the subnet, e.g. "10.0.0.0/24"
+	CIDR       string   `json:"cidr"`
+	GatewayIP  string   `json:"gateway_ip"`
+	EnableDHCP bool     `json:"enable_dhcp"`
+	DNSServers []string `json:"dns_servers"`
+}
+
+// SubnetCreateOptions fields are those accepted by CreateSubnet
+type SubnetCreateOptions struct {
+	Name string `json:"name"`
+	CIDR string `json:"cidr"`
+	// GatewayIP is the first address of the range when empty
+	GatewayIP  string   `json:"gateway_ip,omitempty"`
+	EnableDHCP *bool    `json:"enable_dhcp,omitempty"`
+	DNSServers []string `json:"dns_servers,omitempty"`
+}
+
+// SubnetUpdateOptions fields are those accepted by UpdateSubnet. The range
+// of a subnet can not be changed.
+type SubnetUpdateOptions struct {
+	Name       string   `json:"name,omitempty"`
+	GatewayIP  string   `json:"gateway_ip,omitempty"`
+	EnableDHCP *bool    `json:"enable_dhcp,omitempty"`
+	DNSServers []string `json:"dns_servers,omitempty"`
+}
+
+// GetUpdateOptions converts a Subnet to SubnetUpdateOptions for use in UpdateSubnet
+func (s Subnet) GetUpdateOptions() SubnetUpdateOptions {
+	enableDHCP := s.EnableDHCP
+
+	return SubnetUpdateOptions{
+		Name:       s.Name,
+		GatewayIP:  s.GatewayIP,
+		EnableDHCP: &enableDHCP,
+		DNSServers: s.DNSServers,
+	}
+}
+
+// Network is a private network connecting the servers of a region
+type Network struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Subnets     []Subnet `json:"subnets"`
+	// ServerIDs are the servers attached to the network
+	ServerIDs []string `json:"server_ids"`
+}
+
+// NetworkCreateOptions fields are those accepted by CreateNetwork
+type NetworkCreateOptions struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// NetworkUpdateOptions fields are those accepted by UpdateNetwork.
+// Subnets are managed through the Subnet methods.
+type NetworkUpdateOptions struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// GetUpdateOptions converts a Network to NetworkUpdateOptions for use in UpdateNetwork
+func (n Network) GetUpdateOptions() NetworkUpdateOptions {
+	return NetworkUpdateOptions{
+		Name:        n.Name,
+		Description: n.Description,
+	}
+}
+
+// NetworksPagedResponse represents a paginated Network API response
+type NetworksPagedResponse struct {
+	*PageOptions
+	Data []Network `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for Networks of a region
+func (NetworksPagedResponse) endpointWithID(c *Client, region string) string {
+	endpoint, err := c.Networks.endpointWithParams(region)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends Networks when processing paginated Network responses
+func (resp *NetworksPagedResponse) appendData(r *NetworksPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// SubnetsPagedResponse represents a paginated Subnet API response
+type SubnetsPagedResponse struct {
+	*PageOptions
+	Data []Subnet `json:"data"`
+}
+
+// endpointWithTwoIDs gets the endpoint URL for the Subnets of a Network
+func (SubnetsPagedResponse) endpointWithTwoIDs(c *Client, region, networkID string) string {
+	endpoint, err := c.Subnets.endpointWithParams(region, networkID)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends Subnets when processing paginated Subnet responses
+func (resp *SubnetsPagedResponse) appendData(r *SubnetsPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListNetworks lists the private Networks of a region
+func (c *Client) ListNetworks(ctx context.Context, region string, opts *ListOptions) ([]Network, error) {
+	response := NetworksPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, region, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetNetwork gets a private Network of a region
+func (c *Client) GetNetwork(ctx context.Context, region, networkID string) (*Network, error) {
+	e, err := c.Networks.endpointWithParams(region)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, networkID)
+
+	network := &Network{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(network)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return network, nil
+}
+
+// CreateNetwork creates a private Network in a region
+func (c *Client) CreateNetwork(ctx context.Context, region string, createOpts NetworkCreateOptions) (*Network, error) {
+	e, err := c.Networks.endpointWithParams(region)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	network := &Network{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(network)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return network, nil
+}
+
+// UpdateNetwork updates a private Network of a region
+func (c *Client) UpdateNetwork(ctx context.Context, region, networkID string, updateOpts NetworkUpdateOptions) (*Network, error) {
+	e, err := c.Networks.endpointWithParams(region)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, networkID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	network := &Network{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(network)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return network, nil
+}
+
+// DeleteNetwork deletes a private Network of a region, along with its Subnets
+func (c *Client) DeleteNetwork(ctx context.Context, region, networkID string) error {
+	e, err := c.Networks.endpointWithParams(region)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, networkID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
+
+// ListSubnets lists the Subnets of a Network
+func (c *Client) ListSubnets(ctx context.Context, region, networkID string, opts *ListOptions) ([]Subnet, error) {
+	response := SubnetsPagedResponse{}
+	err := c.listHelperWithTwoIDs(ctx, &response, region, networkID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetSubnet gets a Subnet of a Network
+func (c *Client) GetSubnet(ctx context.Context, region, networkID, subnetID string) (*Subnet, error) {
+	e, err := c.Subnets.endpointWithParams(region, networkID)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, subnetID)
+
+	subnet := &Subnet{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(subnet)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return subnet, nil
+}
+
+// CreateSubnet adds a Subnet to a Network
+func (c *Client) CreateSubnet(ctx context.Context, region, networkID string, createOpts SubnetCreateOptions) (*Subnet, error) {
+	e, err := c.Subnets.endpointWithParams(region, networkID)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	subnet := &Subnet{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(subnet)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return subnet, nil
+}
+
+// UpdateSubnet updates a Subnet of a Network
+func (c *Client) UpdateSubnet(ctx context.Context, region, networkID, subnetID string, updateOpts SubnetUpdateOptions) (*Subnet, error) {
+	e, err := c.Subnets.endpointWithParams(region, networkID)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, subnetID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	subnet := &Subnet{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(subnet)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return subnet, nil
+}
+
+// DeleteSubnet removes a Subnet from a Network
+func (c *Client) DeleteSubnet(ctx context.Context, region, networkID, subnetID string) error {
+	e, err := c.Subnets.endpointWithParams(region, networkID)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, subnetID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
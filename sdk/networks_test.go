@@ -0,0 +1,48 @@
+package sdk
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCreateSubnet(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/ecc/v1/regions/ir-thr-c2/networks/n1/subnets" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"name":"private","cidr":"10.0.0.0/24","enable_dhcp":true,"dns_servers":["1.1.1.1"]}`; string(body) != expected {
+			t.Errorf("expected body %s, got %s", expected, body)
+		}
+
+		writeJSON(w, http.StatusCreated, `{"data":{"id":"sub1","name":"private","cidr":"10.0.0.0/24","gateway_ip":"10.0.0.1","enable_dhcp":true,"dns_servers":["1.1.1.1"]}}`)
+	})
+
+	enableDHCP := true
+	subnet, err := client.CreateSubnet(context.Background(), "ir-thr-c2", "n1", SubnetCreateOptions{
+		Name:       "private",
+		CIDR:       "10.0.0.0/24",
+		EnableDHCP: &enableDHCP,
+		DNSServers: []string{"1.1.1.1"},
+	})
+	if err != nil {
+		t.Fatalf("Error creating subnet: %s", err)
+	}
+
+	expected := &Subnet{
+		ID:         "sub1",
+		Name:       "private",
+		CIDR:       "10.0.0.0/24",
+		GatewayIP:  "10.0.0.1",
+		EnableDHCP: true,
+		DNSServers: []string{"1.1.1.1"},
+	}
+	if !cmp.Equal(subnet, expected) {
+		t.Error(cmp.Diff(subnet, expected))
+	}
+}
@@ -0,0 +1,90 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// OriginProtocol is the protocol the CDN uses to connect to the origin
+type OriginProtocol string
+
+// OriginProtocol enums
+const (
+	OriginProtocolHTTP  OriginProtocol = "http"
+	OriginProtocolHTTPS OriginProtocol = "https"
+	// OriginProtocolMatch uses the protocol of the visitor's request
+	OriginProtocolMatch OriginProtocol = "match"
+)
+
+// OriginSettings represents how the CDN connects to the origin of a Domain
+type OriginSettings struct {
+	// UpstreamHost is the Host header sent to the origin, empty to forward the visitor's
+	UpstreamHost string         `json:"upstream_host"`
+	Protocol     OriginProtocol `json:"protocol"`
+	// Port is the origin port, 0 for the protocol's default
+	Port int `json:"port"`
+	// OriginShield routes cache misses through a single edge location to offload the origin
+	OriginShield bool `json:"origin_shield"`
+	// ConnectTimeout and ReadTimeout are in seconds
+	ConnectTimeout int `json:"connect_timeout"`
+	ReadTimeout    int `json:"read_timeout"`
+}
+
+// OriginSettingsUpdateOptions fields are those accepted by UpdateOriginSettings.
+// Nil fields are not changed.
+type OriginSettingsUpdateOptions struct {
+	UpstreamHost   *string        `json:"upstream_host,omitempty"`
+	Protocol       OriginProtocol `json:"protocol,omitempty"`
+	Port           *int           `json:"port,omitempty"`
+	OriginShield   *bool          `json:"origin_shield,omitempty"`
+	ConnectTimeout *int           `json:"connect_timeout,omitempty"`
+	ReadTimeout    *int           `json:"read_timeout,omitempty"`
+}
+
+// GetUpdateOptions converts OriginSettings to OriginSettingsUpdateOptions
+// setting every field, for use in UpdateOriginSettings
+func (s OriginSettings) GetUpdateOptions() OriginSettingsUpdateOptions {
+	return OriginSettingsUpdateOptions{
+		UpstreamHost:   &s.UpstreamHost,
+		Protocol:       s.Protocol,
+		Port:           &s.Port,
+		OriginShield:   &s.OriginShield,
+		ConnectTimeout: &s.ConnectTimeout,
+		ReadTimeout:    &s.ReadTimeout,
+	}
+}
+
+// GetOriginSettings gets the origin settings of a Domain
+func (c *Client) GetOriginSettings(ctx context.Context, domain string) (*OriginSettings, error) {
+	e, err := c.OriginSettings.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &OriginSettings{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(settings)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// UpdateOriginSettings updates the origin settings of a Domain and returns the resulting settings
+func (c *Client) UpdateOriginSettings(ctx context.Context, domain string, updateOpts OriginSettingsUpdateOptions) (*OriginSettings, error) {
+	e, err := c.OriginSettings.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	settings := &OriginSettings{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(settings)).SetBody(string(bodyData)).Patch(e)); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
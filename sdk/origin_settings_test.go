@@ -0,0 +1,36 @@
+package sdk
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestUpdateOriginSettings_partial(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/cdn/4.0/domains/example.com/origin" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"upstream_host":"","protocol":"https"}`; string(body) != expected {
+			t.Errorf("expected body %s, got %s", expected, body)
+		}
+
+		writeJSON(w, http.StatusOK, `{"data":{"protocol":"https","port":443,"origin_shield":true}}`)
+	})
+
+	upstreamHost := ""
+	settings, err := client.UpdateOriginSettings(context.Background(), "example.com", OriginSettingsUpdateOptions{
+		UpstreamHost: &upstreamHost,
+		Protocol:     OriginProtocolHTTPS,
+	})
+	if err != nil {
+		t.Fatalf("Error updating origin settings: %s", err)
+	}
+
+	if settings.Port != 443 || !settings.OriginShield {
+		t.Errorf("unexpected settings %#v", settings)
+	}
+}
@@ -0,0 +1,189 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// PageRuleCacheLevel controls which requests to a URL pattern are cached
+type PageRuleCacheLevel string
+
+// PageRuleCacheLevel enums
+const (
+	PageRuleCacheOff         PageRuleCacheLevel = "off"
+	PageRuleCacheURI         PageRuleCacheLevel = "uri"
+	PageRuleCacheQueryString PageRuleCacheLevel = "query_string"
+)
+
+// PageRuleSecurityLevel overrides how suspicious requests to a URL pattern are handled
+type PageRuleSecurityLevel string
+
+// PageRuleSecurityLevel enums
+const (
+	PageRuleSecurityOff    PageRuleSecurityLevel = "off"
+	PageRuleSecurityLow    PageRuleSecurityLevel = "low"
+	PageRuleSecurityMedium PageRuleSecurityLevel = "medium"
+	PageRuleSecurityHigh   PageRuleSecurityLevel = "high"
+)
+
+// PageRuleRedirect redirects requests matching a PageRule
+type PageRuleRedirect struct {
+	URL string `json:"url"`
+	// StatusCode is either 301 or 302
+	StatusCode int `json:"status_code"`
+}
+
+// PageRuleSettings are the Domain settings overridden by a PageRule.
+// Empty fields are inherited from the Domain.
+type PageRuleSettings struct {
+	CacheLevel    PageRuleCacheLevel    `json:"cache_level,omitempty"`
+	CacheTTL      *int                  `json:"cache_ttl,omitempty"`
+	SecurityLevel PageRuleSecurityLevel `json:"security_level,omitempty"`
+	Redirect      *PageRuleRedirect     `json:"redirect,omitempty"`
+	Headers       map[string]string     `json:"headers,omitempty"`
+}
+
+// PageRule overrides Domain settings for requests matching a URL pattern
+type PageRule struct {
+	ID         string           `json:"id"`
+	URLPattern string           `json:"url_pattern"`
+	Settings   PageRuleSettings `json:"settings"`
+	Priority   int              `json:"priority"`
+	Enabled    bool             `json:"enabled"`
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+}
+
+// PageRuleCreateOptions fields are those accepted by CreatePageRule
+type PageRuleCreateOptions struct {
+	URLPattern string           `json:"url_pattern"`
+	Settings   PageRuleSettings `json:"settings"`
+	Enabled    *bool            `json:"enabled,omitempty"`
+}
+
+// PageRuleUpdateOptions fields are those accepted by UpdatePageRule
+type PageRuleUpdateOptions struct {
+	URLPattern string            `json:"url_pattern,omitempty"`
+	Settings   *PageRuleSettings `json:"settings,omitempty"`
+	Enabled    *bool             `json:"enabled,omitempty"`
+}
+
+// GetUpdateOptions converts a PageRule to PageRuleUpdateOptions for use in UpdatePageRule
+func (r PageRule) GetUpdateOptions() PageRuleUpdateOptions {
+	settings := r.Settings
+	enabled := r.Enabled
+
+	return PageRuleUpdateOptions{
+		URLPattern: r.URLPattern,
+		Settings:   &settings,
+		Enabled:    &enabled,
+	}
+}
+
+// PageRulesPagedResponse represents a paginated PageRule API response
+type PageRulesPagedResponse struct {
+	*PageOptions
+	Data []PageRule `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for PageRules of a Domain
+func (PageRulesPagedResponse) endpointWithID(c *Client, domain string) string {
+	endpoint, err := c.PageRules.endpointWithParams(domain)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends PageRules when processing paginated PageRule responses
+func (resp *PageRulesPagedResponse) appendData(r *PageRulesPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListPageRules lists the PageRules of a Domain in evaluation order
+func (c *Client) ListPageRules(ctx context.Context, domain string, opts *ListOptions) ([]PageRule, error) {
+	response := PageRulesPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, domain, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetPageRule gets a PageRule of a Domain
+func (c *Client) GetPageRule(ctx context.Context, domain, ruleID string) (*PageRule, error) {
+	e, err := c.PageRules.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	rule := &PageRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// CreatePageRule creates a PageRule on a Domain. New rules are evaluated last.
+func (c *Client) CreatePageRule(ctx context.Context, domain string, createOpts PageRuleCreateOptions) (*PageRule, error) {
+	e, err := c.PageRules.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	rule := &PageRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// UpdatePageRule updates a PageRule of a Domain
+func (c *Client) UpdatePageRule(ctx context.Context, domain, ruleID string, updateOpts PageRuleUpdateOptions) (*PageRule, error) {
+	e, err := c.PageRules.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	rule := &PageRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// DeletePageRule deletes a PageRule of a Domain
+func (c *Client) DeletePageRule(ctx context.Context, domain, ruleID string) error {
+	e, err := c.PageRules.endpointWithParams(domain)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
+
+// ReorderPageRules sets the evaluation order of all PageRules of a Domain in one call
+func (c *Client) ReorderPageRules(ctx context.Context, domain string, ruleIDs []string) error {
+	return c.reorderRules(ctx, c.PageRules, domain, ruleIDs)
+}
@@ -0,0 +1,95 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPageRules(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/cdn/4.0/domains/example.com/page-rules":
+			writeJSON(w, http.StatusOK, `{"data":[{"id":"p1","url_pattern":"/static/*","priority":1,"enabled":true}]}`)
+		case r.Method == http.MethodDelete:
+			writeJSON(w, http.StatusOK, `{"message":"ok"}`)
+		default:
+			writeJSON(w, http.StatusOK, `{"data":{"id":"p1","url_pattern":"/static/*","settings":{"cache_level":"aggressive"},"priority":1,"enabled":true}}`)
+		}
+	})
+
+	ctx := context.Background()
+
+	rule, err := client.CreatePageRule(ctx, "example.com", PageRuleCreateOptions{
+		URLPattern: "/static/*",
+		Settings:   PageRuleSettings{CacheLevel: "aggressive"},
+	})
+	if err != nil {
+		t.Fatalf("Error creating page rule: %s", err)
+	}
+
+	if rule.Settings.CacheLevel != "aggressive" {
+		t.Errorf("unexpected rule %#v", rule)
+	}
+
+	rules, err := client.ListPageRules(ctx, "example.com", nil)
+	if err != nil {
+		t.Fatalf("Error listing page rules: %s", err)
+	}
+
+	if len(rules) != 1 || rules[0].ID != "p1" {
+		t.Errorf("unexpected rules %#v", rules)
+	}
+
+	if _, err := client.GetPageRule(ctx, "example.com", "p1"); err != nil {
+		t.Fatalf("Error getting page rule: %s", err)
+	}
+
+	disabled := false
+	if _, err := client.UpdatePageRule(ctx, "example.com", "p1", PageRuleUpdateOptions{Enabled: &disabled}); err != nil {
+		t.Fatalf("Error updating page rule: %s", err)
+	}
+
+	if err := client.DeletePageRule(ctx, "example.com", "p1"); err != nil {
+		t.Fatalf("Error deleting page rule: %s", err)
+	}
+
+	expected := []string{
+		`POST /cdn/4.0/domains/example.com/page-rules {"url_pattern":"/static/*","settings":{"cache_level":"aggressive"}}`,
+		"GET /cdn/4.0/domains/example.com/page-rules ",
+		"GET /cdn/4.0/domains/example.com/page-rules/p1 ",
+		`PUT /cdn/4.0/domains/example.com/page-rules/p1 {"enabled":false}`,
+		"DELETE /cdn/4.0/domains/example.com/page-rules/p1 ",
+	}
+	if !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+}
+
+func TestReorderPageRules(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/cdn/4.0/domains/example.com/page-rules/priorities" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"rule_ids":["p3","p1","p2"]}`; string(body) != expected {
+			t.Errorf("expected body %s, got %s", expected, body)
+		}
+
+		writeJSON(w, http.StatusOK, `{"message":"rules reordered"}`)
+	})
+
+	if err := client.ReorderPageRules(context.Background(), "example.com", []string{"p3", "p1", "p2"}); err != nil {
+		t.Fatalf("Error reordering page rules: %s", err)
+	}
+}
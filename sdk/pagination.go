@@ -38,15 +38,14 @@ func NewListOptions(page int) *ListOptions {
 }
 
 func applyListOptionsToRequest(opts *ListOptions, req *resty.Request) {
-	if opts != nil {
-		if opts.PageOptions != nil && opts.Meta.CurrentPage > 0 {
+	if opts != nil && opts.PageOptions != nil {
+		if opts.Meta.CurrentPage > 0 {
 			req.SetQueryParam("page", strconv.Itoa(opts.Meta.CurrentPage))
 		}
 
 		if opts.Meta.PerPage > 0 {
 			req.SetQueryParam("per_page", strconv.Itoa(opts.Meta.PerPage))
 		}
-
 	}
 }
 
@@ -78,6 +77,15 @@ func (c *Client) listHelper(ctx context.Context, i interface{}, opts *ListOption
 			results = response.Meta.LastPage
 			v.appendData(response)
 		}
+	case *RegionsPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(RegionsPagedResponse{}).Get(v.endpoint(c))); err == nil {
+			response, ok := r.Result().(*RegionsPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *RegionsPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
 
 	default:
 		log.Fatalf("listHelper interface{} %+v used", i)
@@ -112,3 +120,300 @@ func (c *Client) listHelper(ctx context.Context, i interface{}, opts *ListOption
 
 	return nil
 }
+
+// pageCount reads the number of pages and results from a paged response.
+// Some endpoints return their full list without meta, which counts as a
+// single page.
+func pageCount(p *PageOptions) (pages, results int) {
+	if p == nil {
+		return 1, 0
+	}
+
+	return p.Meta.LastPage, p.Meta.Total
+}
+
+// listHelperWithID abstracts fetching and pagination for GET endpoints that
+// require an Id (second level endpoints, such as domains/{domain}/...).
+// When opts (or opts.Page) is nil, all pages will be fetched and
+// returned in a single (endpoint-specific)PagedResponse
+// opts.results and opts.pages will be updated from the API response
+// nolint
+func (c *Client) listHelperWithID(ctx context.Context, i interface{}, id string, opts *ListOptions) error {
+	var (
+		err     error
+		pages   int
+		results int
+		r       *resty.Response
+	)
+
+	req := c.R(ctx)
+	applyListOptionsToRequest(opts, req)
+
+	switch v := i.(type) {
+	case *DomainRecordsPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(DomainRecordsPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*DomainRecordsPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *DomainRecordsPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *WAFPackagesPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(WAFPackagesPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*WAFPackagesPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *WAFPackagesPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *WAFExclusionsPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(WAFExclusionsPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*WAFExclusionsPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *WAFExclusionsPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *DDoSRulesPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(DDoSRulesPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*DDoSRulesPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *DDoSRulesPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *RateLimitRulesPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(RateLimitRulesPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*RateLimitRulesPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *RateLimitRulesPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *PageRulesPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(PageRulesPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*PageRulesPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *PageRulesPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *LoadBalancerPoolsPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(LoadBalancerPoolsPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*LoadBalancerPoolsPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *LoadBalancerPoolsPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *HealthChecksPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(HealthChecksPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*HealthChecksPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *HealthChecksPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *LogForwardersPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(LogForwardersPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*LogForwardersPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *LogForwardersPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *HeaderRulesPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(HeaderRulesPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*HeaderRulesPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *HeaderRulesPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *ServersPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(ServersPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*ServersPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *ServersPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *ImagesPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(ImagesPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*ImagesPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *ImagesPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *FlavorsPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(FlavorsPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*FlavorsPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *FlavorsPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *SecurityGroupsPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(SecurityGroupsPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*SecurityGroupsPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *SecurityGroupsPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *FloatingIPsPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(FloatingIPsPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*FloatingIPsPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *FloatingIPsPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *NetworksPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(NetworksPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
+			response, ok := r.Result().(*NetworksPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *NetworksPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+
+	default:
+		log.Fatalf("Unknown listHelperWithID interface{} %T used", i)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if opts == nil {
+		for page := 2; page <= pages; page++ {
+			if err := c.listHelperWithID(ctx, i, id, &ListOptions{PageOptions: &PageOptions{Meta: Meta{CurrentPage: page}}}); err != nil {
+				return err
+			}
+		}
+	} else {
+		if opts.PageOptions == nil {
+			opts.PageOptions = &PageOptions{}
+		}
+
+		if opts.Meta.CurrentPage == 0 {
+			for page := 2; page <= pages; page++ {
+				opts.Meta.CurrentPage = page
+				if err := c.listHelperWithID(ctx, i, id, opts); err != nil {
+					return err
+				}
+			}
+		}
+		opts.Meta.Total = results
+		opts.Meta.LastPage = pages
+	}
+
+	return nil
+}
+
+// listHelperWithTwoIDs abstracts fetching and pagination for GET endpoints that
+// require two ids (third level endpoints).
+// When opts (or opts.Page) is nil, all pages will be fetched and
+// returned in a single (endpoint-specific)PagedResponse
+// opts.results and opts.pages will be updated from the API response
+// nolint
+func (c *Client) listHelperWithTwoIDs(ctx context.Context, i interface{}, firstID, secondID string, opts *ListOptions) error {
+	var (
+		err     error
+		pages   int
+		results int
+		r       *resty.Response
+	)
+
+	req := c.R(ctx)
+	applyListOptionsToRequest(opts, req)
+
+	switch v := i.(type) {
+	case *WAFRulesPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(WAFRulesPagedResponse{}).Get(v.endpointWithTwoIDs(c, firstID, secondID))); err == nil {
+			response, ok := r.Result().(*WAFRulesPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *WAFRulesPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *LoadBalancerOriginsPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(LoadBalancerOriginsPagedResponse{}).Get(v.endpointWithTwoIDs(c, firstID, secondID))); err == nil {
+			response, ok := r.Result().(*LoadBalancerOriginsPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *LoadBalancerOriginsPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *SecurityGroupRulesPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(SecurityGroupRulesPagedResponse{}).Get(v.endpointWithTwoIDs(c, firstID, secondID))); err == nil {
+			response, ok := r.Result().(*SecurityGroupRulesPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *SecurityGroupRulesPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+	case *SubnetsPagedResponse:
+		if r, err = coupleAPIErrors(req.SetResult(SubnetsPagedResponse{}).Get(v.endpointWithTwoIDs(c, firstID, secondID))); err == nil {
+			response, ok := r.Result().(*SubnetsPagedResponse)
+			if !ok {
+				return fmt.Errorf("response is not a *SubnetsPagedResponse")
+			}
+			pages, results = pageCount(response.PageOptions)
+			v.appendData(response)
+		}
+
+	default:
+		log.Fatalf("Unknown listHelperWithTwoIDs interface{} %T used", i)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if opts == nil {
+		for page := 2; page <= pages; page++ {
+			if err := c.listHelperWithTwoIDs(ctx, i, firstID, secondID, &ListOptions{PageOptions: &PageOptions{Meta: Meta{CurrentPage: page}}}); err != nil {
+				return err
+			}
+		}
+	} else {
+		if opts.PageOptions == nil {
+			opts.PageOptions = &PageOptions{}
+		}
+
+		if opts.Meta.CurrentPage == 0 {
+			for page := 2; page <= pages; page++ {
+				opts.Meta.CurrentPage = page
+				if err := c.listHelperWithTwoIDs(ctx, i, firstID, secondID, opts); err != nil {
+					return err
+				}
+			}
+		}
+		opts.Meta.Total = results
+		opts.Meta.LastPage = pages
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// RateLimitAction is what happens to requests over a RateLimitRule's threshold
+type RateLimitAction string
+
+// RateLimitAction enums
+const (
+	RateLimitActionBlock     RateLimitAction = "block"
+	RateLimitActionChallenge RateLimitAction = "challenge"
+	RateLimitActionLog       RateLimitAction = "log"
+)
+
+// RateLimitRule limits the requests a client may send to URLs matching a pattern.
+// Editable when Domain.Features.EditableRateLimitRules is set.
+type RateLimitRule struct {
+	ID         string   `json:"id"`
+	URLPattern string   `json:"url_pattern"`
+	Methods    []string `json:"methods"`
+	// Threshold is the number of requests allowed per Period seconds
+	Threshold   int             `json:"threshold"`
+	Period      int             `json:"period"`
+	Action      RateLimitAction `json:"action"`
+	ExemptIPs   []string        `json:"exempt_ips"`
+	Priority    int             `json:"priority"`
+	Enabled     bool            `json:"enabled"`
+	Description string          `json:"description"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// RateLimitRuleCreateOptions fields are those accepted by CreateRateLimitRule
+type RateLimitRuleCreateOptions struct {
+	URLPattern  string          `json:"url_pattern"`
+	Methods     []string        `json:"methods,omitempty"`
+	Threshold   int             `json:"threshold"`
+	Period      int             `json:"period"`
+	Action      RateLimitAction `json:"action"`
+	ExemptIPs   []string        `json:"exempt_ips,omitempty"`
+	Enabled     *bool           `json:"enabled,omitempty"`
+	Description string          `json:"description,omitempty"`
+}
+
+// RateLimitRuleUpdateOptions fields are those accepted by UpdateRateLimitRule
+type RateLimitRuleUpdateOptions struct {
+	URLPattern string          `json:"url_pattern,omitempty"`
+	Methods    []string        `json:"methods,omitempty"`
+	Threshold  int             `json:"threshold,omitempty"`
+	Period     int             `json:"period,omitempty"`
+	Action     RateLimitAction `json:"action,omitempty"`
+	// ExemptIPs are left unchanged when nil, point to an empty slice to clear them
+	ExemptIPs   *[]string `json:"exempt_ips,omitempty"`
+	Enabled     *bool     `json:"enabled,omitempty"`
+	Description string    `json:"description,omitempty"`
+}
+
+// GetUpdateOptions converts a RateLimitRule to RateLimitRuleUpdateOptions for use in UpdateRateLimitRule
+func (r RateLimitRule) GetUpdateOptions() RateLimitRuleUpdateOptions {
+	enabled := r.Enabled
+	exemptIPs := append([]string{}, r.ExemptIPs...)
+
+	return RateLimitRuleUpdateOptions{
+		URLPattern:  r.URLPattern,
+		Methods:     r.Methods,
+		Threshold:   r.Threshold,
+		Period:      r.Period,
+		Action:      r.Action,
+		ExemptIPs:   &exemptIPs,
+		Enabled:     &enabled,
+		Description: r.Description,
+	}
+}
+
+// RateLimitRulesPagedResponse represents a paginated RateLimitRule API response
+type RateLimitRulesPagedResponse struct {
+	*PageOptions
+	Data []RateLimitRule `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for RateLimitRules of a Domain
+func (RateLimitRulesPagedResponse) endpointWithID(c *Client, domain string) string {
+	endpoint, err := c.RateLimitRules.endpointWithParams(domain)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends RateLimitRules when processing paginated RateLimitRule responses
+func (resp *RateLimitRulesPagedResponse) appendData(r *RateLimitRulesPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListRateLimitRules lists the RateLimitRules of a Domain in evaluation order
+func (c *Client) ListRateLimitRules(ctx context.Context, domain string, opts *ListOptions) ([]RateLimitRule, error) {
+	response := RateLimitRulesPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, domain, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetRateLimitRule gets a RateLimitRule of a Domain
+func (c *Client) GetRateLimitRule(ctx context.Context, domain, ruleID string) (*RateLimitRule, error) {
+	e, err := c.RateLimitRules.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	rule := &RateLimitRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// CreateRateLimitRule creates a RateLimitRule on a Domain. New rules are evaluated last.
+func (c *Client) CreateRateLimitRule(ctx context.Context, domain string, createOpts RateLimitRuleCreateOptions) (*RateLimitRule, error) {
+	e, err := c.RateLimitRules.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	rule := &RateLimitRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// UpdateRateLimitRule updates a RateLimitRule of a Domain
+func (c *Client) UpdateRateLimitRule(ctx context.Context, domain, ruleID string, updateOpts RateLimitRuleUpdateOptions) (*RateLimitRule, error) {
+	e, err := c.RateLimitRules.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	rule := &RateLimitRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// DeleteRateLimitRule deletes a RateLimitRule of a Domain
+func (c *Client) DeleteRateLimitRule(ctx context.Context, domain, ruleID string) error {
+	e, err := c.RateLimitRules.endpointWithParams(domain)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
+
+// ReorderRateLimitRules sets the evaluation order of all RateLimitRules of a Domain
+func (c *Client) ReorderRateLimitRules(ctx context.Context, domain string, ruleIDs []string) error {
+	return c.reorderRules(ctx, c.RateLimitRules, domain, ruleIDs)
+}
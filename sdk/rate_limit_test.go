@@ -0,0 +1,74 @@
+package sdk
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestListRateLimitRules_withOptions(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if page := r.URL.Query().Get("page"); page != "2" {
+			t.Errorf("expected page 2 to be requested, got %q", page)
+		}
+
+		writeJSON(w, http.StatusOK, `{"data":[{"id":"login","threshold":10,"period":60}],"meta":{"current_page":2,"last_page":3,"total":21}}`)
+	})
+
+	opts := NewListOptions(2)
+	rules, err := client.ListRateLimitRules(context.Background(), "example.com", opts)
+	if err != nil {
+		t.Fatalf("Error listing rate limit rules: %s", err)
+	}
+
+	if len(rules) != 1 || rules[0].Threshold != 10 {
+		t.Errorf("unexpected rules %#v", rules)
+	}
+
+	if opts.Meta.Total != 21 || opts.Meta.LastPage != 3 {
+		t.Errorf("expected list options to be updated from meta, got %#v", opts.Meta)
+	}
+}
+
+func TestReorderRateLimitRules(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/cdn/4.0/domains/example.com/rate-limit/rules/priorities" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"rule_ids":["b","a"]}`; string(body) != expected {
+			t.Errorf("expected body %s, got %s", expected, body)
+		}
+
+		writeJSON(w, http.StatusOK, `{"message":"rules reordered"}`)
+	})
+
+	if err := client.ReorderRateLimitRules(context.Background(), "example.com", []string{"b", "a"}); err != nil {
+		t.Fatalf("Error reordering rate limit rules: %s", err)
+	}
+}
+
+func TestUpdateRateLimitRule_exemptIPs(t *testing.T) {
+	var bodies []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		writeJSON(w, http.StatusOK, `{"data":{"id":"r1"}}`)
+	})
+
+	if _, err := client.UpdateRateLimitRule(context.Background(), "example.com", "r1", RateLimitRuleUpdateOptions{Threshold: 200}); err != nil {
+		t.Fatalf("Error updating rule: %s", err)
+	}
+
+	if _, err := client.UpdateRateLimitRule(context.Background(), "example.com", "r1", RateLimitRuleUpdateOptions{ExemptIPs: &[]string{}}); err != nil {
+		t.Fatalf("Error updating rule: %s", err)
+	}
+
+	expected := []string{`{"threshold":200}`, `{"exempt_ips":[]}`}
+	if len(bodies) != 2 || bodies[0] != expected[0] || bodies[1] != expected[1] {
+		t.Errorf("expected bodies %v, got %v", expected, bodies)
+	}
+}
@@ -0,0 +1,47 @@
+package sdk
+
+import (
+	"context"
+)
+
+// Region is an IaaS region servers and networks are created in, e.g. "ir-thr-c2"
+type Region struct {
+	Code       string `json:"code"`
+	Country    string `json:"country"`
+	City       string `json:"city"`
+	DataCenter string `json:"dc"`
+	Visible    bool   `json:"visible"`
+	Default    bool   `json:"default"`
+}
+
+// RegionsPagedResponse represents a paginated Region API response
+type RegionsPagedResponse struct {
+	*PageOptions
+	Data []Region `json:"data"`
+}
+
+// endpoint gets the endpoint URL for Regions
+func (RegionsPagedResponse) endpoint(c *Client) string {
+	endpoint, err := c.Regions.Endpoint()
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends Regions when processing paginated Region responses
+func (resp *RegionsPagedResponse) appendData(r *RegionsPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListRegions lists the IaaS regions
+func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region, error) {
+	response := RegionsPagedResponse{}
+	err := c.listHelper(ctx, &response, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
@@ -0,0 +1,362 @@
+package sdk
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// prometheusMetricPrefix is prepended to the name of every exported ReportSeries
+const prometheusMetricPrefix = "arvancloud_"
+
+// ReportSeries is a named time series of a report
+type ReportSeries struct {
+	Name   string
+	Labels map[string]string
+	Points []ReportPoint
+}
+
+// TimeSeriesReport is a report that can be exported with WriteReportCSV and WriteReportPrometheus
+type TimeSeriesReport interface {
+	TimeSeries() []ReportSeries
+}
+
+// TimeSeries returns the time series of a TrafficReport
+func (r TrafficReport) TimeSeries() []ReportSeries {
+	return []ReportSeries{
+		{Name: "requests", Points: r.Requests},
+		{Name: "cached_requests", Points: r.CachedRequests},
+		{Name: "uncached_requests", Points: r.UncachedRequests},
+		{Name: "bandwidth_bytes", Points: r.Bandwidth},
+		{Name: "cached_bandwidth_bytes", Points: r.CachedBandwidth},
+		{Name: "uncached_bandwidth_bytes", Points: r.UncachedBandwidth},
+	}
+}
+
+// TimeSeries returns the time series of a VisitorsReport
+func (r VisitorsReport) TimeSeries() []ReportSeries {
+	return []ReportSeries{{Name: "visitors", Points: r.Visitors}}
+}
+
+// TimeSeries returns the time series of a StatusCodesReport, one per status class
+func (r StatusCodesReport) TimeSeries() []ReportSeries {
+	classes := make([]string, 0, len(r.Series))
+	for class := range r.Series {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+
+	series := make([]ReportSeries, 0, len(classes))
+	for _, class := range classes {
+		series = append(series, ReportSeries{
+			Name:   "status_code_requests",
+			Labels: map[string]string{"class": class},
+			Points: r.Series[class],
+		})
+	}
+
+	return series
+}
+
+// TimeSeries returns the time series of an AttacksReport
+func (r AttacksReport) TimeSeries() []ReportSeries {
+	return []ReportSeries{{Name: "attacks", Points: r.Attacks}}
+}
+
+// column is the CSV header of the series, its name followed by its sorted label values
+func (s ReportSeries) column() string {
+	keys := sortedLabelKeys(s.Labels)
+	parts := append(make([]string, 0, len(keys)+1), s.Name)
+	for _, k := range keys {
+		parts = append(parts, s.Labels[k])
+	}
+
+	return strings.Join(parts, "_")
+}
+
+func sortedLabelKeys(labels map[string]string) []string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// WriteReportCSV writes the time series of reports to w as CSV with a time column
+// followed by one column per series. Rows are ordered by time; a series without a
+// point at a row's time leaves its cell empty.
+func WriteReportCSV(w io.Writer, reports ...TimeSeriesReport) error {
+	var series []ReportSeries
+	for _, report := range reports {
+		series = append(series, report.TimeSeries()...)
+	}
+
+	header := []string{"time"}
+	values := make([]map[time.Time]float64, len(series))
+	times := map[time.Time]struct{}{}
+
+	for i, s := range series {
+		header = append(header, s.column())
+		values[i] = make(map[time.Time]float64, len(s.Points))
+
+		for _, p := range s.Points {
+			t := p.Time.UTC()
+			values[i][t] = p.Value
+			times[t] = struct{}{}
+		}
+	}
+
+	rows := make([]time.Time, 0, len(times))
+	for t := range times {
+		rows = append(rows, t)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Before(rows[j]) })
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, t := range rows {
+		record := []string{t.Format(time.RFC3339)}
+		for i := range series {
+			cell := ""
+			if v, ok := values[i][t]; ok {
+				cell = strconv.FormatFloat(v, 'f', -1, 64)
+			}
+			record = append(record, cell)
+		}
+
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteReportPrometheus writes the latest point of every time series of reports to w
+// as gauges in the Prometheus text exposition format, labelled with domain
+func WriteReportPrometheus(w io.Writer, domain string, reports ...TimeSeriesReport) error {
+	var series []ReportSeries
+	for _, report := range reports {
+		series = append(series, withLabel(report.TimeSeries(), "domain", domain)...)
+	}
+
+	return writePrometheusSeries(w, series)
+}
+
+// withLabel returns a copy of series with the label name set to value
+func withLabel(series []ReportSeries, name, value string) []ReportSeries {
+	labelled := make([]ReportSeries, 0, len(series))
+	for _, s := range series {
+		labels := map[string]string{name: value}
+		for k, v := range s.Labels {
+			labels[k] = v
+		}
+		s.Labels = labels
+		labelled = append(labelled, s)
+	}
+
+	return labelled
+}
+
+// writePrometheusSeries writes series grouped by metric name, so every metric has a
+// single TYPE line. Series without points are skipped.
+func writePrometheusSeries(w io.Writer, series []ReportSeries) error {
+	var names []string
+	byName := map[string][]ReportSeries{}
+
+	for _, s := range series {
+		if len(s.Points) == 0 {
+			continue
+		}
+
+		if _, ok := byName[s.Name]; !ok {
+			names = append(names, s.Name)
+		}
+		byName[s.Name] = append(byName[s.Name], s)
+	}
+
+	for _, name := range names {
+		metric := prometheusMetricPrefix + name
+		if _, err := fmt.Fprintf(w, "# TYPE %s gauge\n", metric); err != nil {
+			return err
+		}
+
+		for _, s := range byName[name] {
+			latest := s.Points[0]
+			for _, p := range s.Points[1:] {
+				if p.Time.After(latest.Time) {
+					latest = p
+				}
+			}
+
+			if _, err := fmt.Fprintf(w, "%s%s %s\n", metric, formatPrometheusLabels(s.Labels), strconv.FormatFloat(latest.Value, 'g', -1, 64)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+var prometheusLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatPrometheusLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	pairs := make([]string, 0, len(labels))
+	for _, k := range sortedLabelKeys(labels) {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, k, prometheusLabelEscaper.Replace(labels[k])))
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// ReportCollector periodically fetches the traffic, visitors, status code and attack
+// reports of a set of Domains and serves their latest values to Prometheus as an http.Handler
+type ReportCollector struct {
+	client   *Client
+	domains  []string
+	interval time.Duration
+	opts     *ReportOptions
+
+	mu     sync.RWMutex
+	series map[string][]ReportSeries
+	up     map[string]bool
+}
+
+// DefaultReportCollectorInterval is the scrape interval of a ReportCollector created
+// with a zero or negative interval
+const DefaultReportCollectorInterval = time.Minute
+
+// NewReportCollector creates a ReportCollector for domains, scraping every interval
+// once started, DefaultReportCollectorInterval when interval is not positive.
+// Reports are requested with opts, which may be nil.
+func NewReportCollector(client *Client, domains []string, interval time.Duration, opts *ReportOptions) *ReportCollector {
+	if interval <= 0 {
+		interval = DefaultReportCollectorInterval
+	}
+
+	return &ReportCollector{
+		client:   client,
+		domains:  domains,
+		interval: interval,
+		opts:     opts,
+		series:   map[string][]ReportSeries{},
+		up:       map[string]bool{},
+	}
+}
+
+// Start scrapes the reports immediately and then every interval until ctx is done
+func (rc *ReportCollector) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(rc.interval)
+		defer ticker.Stop()
+
+		for {
+			if err := rc.Scrape(ctx); err != nil {
+				log.Printf("[WARN] Scraping Arvancloud reports: %s", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Scrape fetches the reports of every Domain once. A Domain whose reports cannot be
+// fetched keeps its previous values and is reported as down; the last error is returned.
+func (rc *ReportCollector) Scrape(ctx context.Context) error {
+	var lastErr error
+
+	for _, domain := range rc.domains {
+		series, err := rc.scrapeDomain(ctx, domain)
+
+		rc.mu.Lock()
+		rc.up[domain] = err == nil
+		if err == nil {
+			rc.series[domain] = series
+		}
+		rc.mu.Unlock()
+
+		if err != nil {
+			lastErr = fmt.Errorf("domain %s: %w", domain, err)
+		}
+	}
+
+	return lastErr
+}
+
+func (rc *ReportCollector) scrapeDomain(ctx context.Context, domain string) ([]ReportSeries, error) {
+	traffic, err := rc.client.GetTrafficReport(ctx, domain, rc.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	visitors, err := rc.client.GetVisitorsReport(ctx, domain, rc.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	statusCodes, err := rc.client.GetStatusCodesReport(ctx, domain, rc.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	attacks, err := rc.client.GetAttacksReport(ctx, domain, rc.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var series []ReportSeries
+	for _, report := range []TimeSeriesReport{traffic, visitors, statusCodes, attacks} {
+		series = append(series, withLabel(report.TimeSeries(), "domain", domain)...)
+	}
+
+	return series, nil
+}
+
+// ServeHTTP writes the latest scraped values in the Prometheus text exposition format
+func (rc *ReportCollector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	var series []ReportSeries
+
+	for _, domain := range rc.domains {
+		series = append(series, rc.series[domain]...)
+
+		value := 0.0
+		if rc.up[domain] {
+			value = 1
+		}
+		series = append(series, ReportSeries{
+			Name:   "report_scrape_success",
+			Labels: map[string]string{"domain": domain},
+			Points: []ReportPoint{{Value: value}},
+		})
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := writePrometheusSeries(w, series); err != nil {
+		log.Printf("[WARN] Writing Arvancloud report metrics: %s", err)
+	}
+}
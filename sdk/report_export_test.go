@@ -0,0 +1,106 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWriteReportCSV(t *testing.T) {
+	t0 := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+
+	report := StatusCodesReport{Series: map[string][]ReportPoint{
+		"5xx": {{Time: t1, Value: 2}},
+		"2xx": {{Time: t0, Value: 10}, {Time: t1, Value: 12.5}},
+	}}
+
+	var buf bytes.Buffer
+	if err := WriteReportCSV(&buf, report); err != nil {
+		t.Fatalf("Error writing csv: %s", err)
+	}
+
+	expected := "time,status_code_requests_2xx,status_code_requests_5xx\n" +
+		"2021-12-01T00:00:00Z,10,\n" +
+		"2021-12-01T01:00:00Z,12.5,2\n"
+	if buf.String() != expected {
+		t.Error(cmp.Diff(buf.String(), expected))
+	}
+}
+
+func TestWriteReportPrometheus(t *testing.T) {
+	t0 := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	report := TrafficReport{
+		Requests:  []ReportPoint{{Time: t0.Add(time.Hour), Value: 7}, {Time: t0, Value: 3}},
+		Bandwidth: []ReportPoint{{Time: t0, Value: 2048}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteReportPrometheus(&buf, `exa"mple.com`, report); err != nil {
+		t.Fatalf("Error writing exposition: %s", err)
+	}
+
+	expected := "# TYPE arvancloud_requests gauge\n" +
+		`arvancloud_requests{domain="exa\"mple.com"} 7` + "\n" +
+		"# TYPE arvancloud_bandwidth_bytes gauge\n" +
+		`arvancloud_bandwidth_bytes{domain="exa\"mple.com"} 2048` + "\n"
+	if buf.String() != expected {
+		t.Error(cmp.Diff(buf.String(), expected))
+	}
+}
+
+func TestReportCollector(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/cdn/4.0/domains/broken.com/") {
+			writeJSON(w, http.StatusNotFound, `{"errors":[{"reason":"domain not found"}]}`)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/cdn/4.0/domains/example.com/reports/visitors":
+			writeJSON(w, http.StatusOK, `{"data":{"visitors":[{"time":"2021-12-01T00:00:00Z","value":5}]}}`)
+		default:
+			writeJSON(w, http.StatusOK, `{"data":{}}`)
+		}
+	})
+
+	collector := NewReportCollector(client, []string{"example.com", "broken.com"}, time.Minute, nil)
+	if err := collector.Scrape(context.Background()); err == nil {
+		t.Error("expected scrape error for broken.com")
+	}
+
+	rec := httptest.NewRecorder()
+	collector.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	expected := "# TYPE arvancloud_visitors gauge\n" +
+		`arvancloud_visitors{domain="example.com"} 5` + "\n" +
+		"# TYPE arvancloud_report_scrape_success gauge\n" +
+		`arvancloud_report_scrape_success{domain="example.com"} 1` + "\n" +
+		`arvancloud_report_scrape_success{domain="broken.com"} 0` + "\n"
+	if rec.Body.String() != expected {
+		t.Error(cmp.Diff(rec.Body.String(), expected))
+	}
+}
+
+func TestNewReportCollector_interval(t *testing.T) {
+	client := NewClient("")
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		collector := NewReportCollector(&client, nil, interval, nil)
+		if collector.interval != DefaultReportCollectorInterval {
+			t.Errorf("%s: expected the default interval, got %s", interval, collector.interval)
+		}
+	}
+
+	// Starting with a defaulted interval must not panic
+	ctx, cancel := context.WithCancel(context.Background())
+	NewReportCollector(&client, nil, 0, nil).Start(ctx)
+	cancel()
+}
@@ -0,0 +1,197 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ReportPeriod is a predefined time range a report covers, ending now
+type ReportPeriod string
+
+// ReportPeriod enums
+const (
+	ReportPeriod3Hours  ReportPeriod = "3h"
+	ReportPeriod24Hours ReportPeriod = "24h"
+	ReportPeriod7Days   ReportPeriod = "7d"
+	ReportPeriod30Days  ReportPeriod = "30d"
+)
+
+// ReportOptions select the time range of a report. Since and Until take
+// precedence over Period when set; with neither, the API defaults to 24 hours.
+type ReportOptions struct {
+	Period ReportPeriod
+	Since  time.Time
+	Until  time.Time
+}
+
+func applyReportOptionsToRequest(opts *ReportOptions, req *resty.Request) {
+	if opts == nil {
+		return
+	}
+
+	if !opts.Since.IsZero() || !opts.Until.IsZero() {
+		if !opts.Since.IsZero() {
+			req.SetQueryParam("since", opts.Since.UTC().Format(time.RFC3339))
+		}
+
+		if !opts.Until.IsZero() {
+			req.SetQueryParam("until", opts.Until.UTC().Format(time.RFC3339))
+		}
+
+		return
+	}
+
+	if opts.Period != "" {
+		req.SetQueryParam("period", string(opts.Period))
+	}
+}
+
+// ReportPoint is a single value of a report time series
+type ReportPoint struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+// TrafficTotals sums a TrafficReport over its whole time range. Bandwidth is in bytes.
+type TrafficTotals struct {
+	Requests          int64 `json:"requests"`
+	CachedRequests    int64 `json:"cached_requests"`
+	UncachedRequests  int64 `json:"uncached_requests"`
+	Bandwidth         int64 `json:"bandwidth"`
+	CachedBandwidth   int64 `json:"cached_bandwidth"`
+	UncachedBandwidth int64 `json:"uncached_bandwidth"`
+}
+
+// TrafficReport represents the requests served and bandwidth used by a Domain.
+// Bandwidth series are in bytes.
+type TrafficReport struct {
+	Requests          []ReportPoint `json:"requests"`
+	CachedRequests    []ReportPoint `json:"cached_requests"`
+	UncachedRequests  []ReportPoint `json:"uncached_requests"`
+	Bandwidth         []ReportPoint `json:"bandwidth"`
+	CachedBandwidth   []ReportPoint `json:"cached_bandwidth"`
+	UncachedBandwidth []ReportPoint `json:"uncached_bandwidth"`
+	Totals            TrafficTotals `json:"totals"`
+}
+
+// VisitorsReport represents the unique visitors of a Domain
+type VisitorsReport struct {
+	Visitors []ReportPoint `json:"visitors"`
+	Total    int64         `json:"total"`
+}
+
+// StatusCodesReport represents the distribution of response status codes of a Domain,
+// keyed by status class ("2xx", "3xx", "4xx", "5xx")
+type StatusCodesReport struct {
+	Series map[string][]ReportPoint `json:"series"`
+	Totals map[string]int64         `json:"totals"`
+}
+
+// TopURL is an entry of the most requested URLs of a Domain. Bandwidth is in bytes.
+type TopURL struct {
+	URL       string `json:"url"`
+	Requests  int64  `json:"requests"`
+	Bandwidth int64  `json:"bandwidth"`
+}
+
+// TopCountry is an entry of the countries most visitors of a Domain come from
+type TopCountry struct {
+	// Code is the ISO 3166-1 alpha-2 code of the country
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	Requests int64  `json:"requests"`
+	Visitors int64  `json:"visitors"`
+}
+
+// Attacker is a source of attacks against a Domain
+type Attacker struct {
+	IP          string `json:"ip"`
+	CountryCode string `json:"country_code"`
+	Count       int64  `json:"count"`
+}
+
+// AttacksReport represents the attacks blocked for a Domain, with totals keyed by
+// the blocking feature ("waf", "ddos", "rate_limit", "firewall")
+type AttacksReport struct {
+	Attacks      []ReportPoint    `json:"attacks"`
+	Totals       map[string]int64 `json:"totals"`
+	TopAttackers []Attacker       `json:"top_attackers"`
+}
+
+// getReport fetches the report named name of a Domain into result
+func (c *Client) getReport(ctx context.Context, domain, name string, opts *ReportOptions, result interface{}) error {
+	e, err := c.Reports.endpointWithParams(domain)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, name)
+
+	req := c.R(ctx).SetResult(wrapResult(result))
+	applyReportOptionsToRequest(opts, req)
+
+	_, err = coupleAPIErrors(req.Get(e))
+	return err
+}
+
+// GetTrafficReport gets the requests and bandwidth of a Domain, split into cached and uncached
+func (c *Client) GetTrafficReport(ctx context.Context, domain string, opts *ReportOptions) (*TrafficReport, error) {
+	report := &TrafficReport{}
+	if err := c.getReport(ctx, domain, "traffics", opts, report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// GetVisitorsReport gets the unique visitors of a Domain
+func (c *Client) GetVisitorsReport(ctx context.Context, domain string, opts *ReportOptions) (*VisitorsReport, error) {
+	report := &VisitorsReport{}
+	if err := c.getReport(ctx, domain, "visitors", opts, report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// GetStatusCodesReport gets the distribution of response status codes of a Domain
+func (c *Client) GetStatusCodesReport(ctx context.Context, domain string, opts *ReportOptions) (*StatusCodesReport, error) {
+	report := &StatusCodesReport{}
+	if err := c.getReport(ctx, domain, "status", opts, report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// GetTopURLs gets the most requested URLs of a Domain
+func (c *Client) GetTopURLs(ctx context.Context, domain string, opts *ReportOptions) ([]TopURL, error) {
+	urls := []TopURL{}
+	if err := c.getReport(ctx, domain, "urls", opts, &urls); err != nil {
+		return nil, err
+	}
+
+	return urls, nil
+}
+
+// GetTopCountries gets the countries most visitors of a Domain come from
+func (c *Client) GetTopCountries(ctx context.Context, domain string, opts *ReportOptions) ([]TopCountry, error) {
+	countries := []TopCountry{}
+	if err := c.getReport(ctx, domain, "countries", opts, &countries); err != nil {
+		return nil, err
+	}
+
+	return countries, nil
+}
+
+// GetAttacksReport gets the attacks blocked for a Domain
+func (c *Client) GetAttacksReport(ctx context.Context, domain string, opts *ReportOptions) (*AttacksReport, error) {
+	report := &AttacksReport{}
+	if err := c.getReport(ctx, domain, "attacks", opts, report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
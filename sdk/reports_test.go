@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetTrafficReport(t *testing.T) {
+	since := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(time.Hour)
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cdn/4.0/domains/example.com/reports/traffics" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if query.Get("since") != "2021-12-01T00:00:00Z" || query.Get("until") != "2021-12-01T01:00:00Z" || query.Get("period") != "" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+
+		writeJSON(w, http.StatusOK, `{"data":{"requests":[{"time":"2021-12-01T00:00:00Z","value":42}],"totals":{"requests":42,"cached_requests":40}}}`)
+	})
+
+	report, err := client.GetTrafficReport(context.Background(), "example.com", &ReportOptions{
+		Period: ReportPeriod7Days,
+		Since:  since,
+		Until:  until,
+	})
+	if err != nil {
+		t.Fatalf("Error getting traffic report: %s", err)
+	}
+
+	expected := []ReportPoint{{Time: since, Value: 42}}
+	if !cmp.Equal(report.Requests, expected) {
+		t.Error(cmp.Diff(report.Requests, expected))
+	}
+
+	if report.Totals.CachedRequests != 40 {
+		t.Errorf("expected 40 cached requests, got %d", report.Totals.CachedRequests)
+	}
+}
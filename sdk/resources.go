@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"text/template"
@@ -15,7 +16,90 @@ const (
 	domainsName           = "domains"
 	domainsEndpoint       = "domains"
 	accountEndpoint       = "account"
-	domainRecordsEndpoint = "domains/{{ .ID }}/records"
+	domainRecordsEndpoint = "domains/{{ .ID }}/dns-records"
+
+	wafPackagesName       = "wafpackages"
+	wafRulesName          = "wafrules"
+	wafExclusionsName     = "wafexclusions"
+	wafPackagesEndpoint   = "domains/{{ .ID }}/waf/packages"
+	wafRulesEndpoint      = "domains/{{ .ID }}/waf/packages/{{ .SecondID }}/rules"
+	wafExclusionsEndpoint = "domains/{{ .ID }}/waf/exclusions"
+
+	ddosSettingsName     = "ddossettings"
+	ddosSettingsEndpoint = "domains/{{ .ID }}/ddos"
+
+	ddosRulesName     = "ddosrules"
+	ddosRulesEndpoint = "domains/{{ .ID }}/ddos/rules"
+
+	rateLimitRulesName     = "ratelimitrules"
+	rateLimitRulesEndpoint = "domains/{{ .ID }}/rate-limit/rules"
+
+	pageRulesName     = "pagerules"
+	pageRulesEndpoint = "domains/{{ .ID }}/page-rules"
+
+	loadBalancerPoolsName     = "loadbalancerpools"
+	loadBalancerPoolsEndpoint = "domains/{{ .ID }}/load-balancers/pools"
+
+	loadBalancerOriginsName     = "loadbalancerorigins"
+	loadBalancerOriginsEndpoint = "domains/{{ .ID }}/load-balancers/pools/{{ .SecondID }}/origins"
+
+	healthChecksName     = "healthchecks"
+	healthChecksEndpoint = "domains/{{ .ID }}/health-checks"
+
+	reportsName     = "reports"
+	reportsEndpoint = "domains/{{ .ID }}/reports"
+
+	logForwardersName     = "logforwarders"
+	logForwardersEndpoint = "domains/{{ .ID }}/log-forwarders"
+
+	customPagesName     = "custompages"
+	customPagesEndpoint = "domains/{{ .ID }}/custom-pages"
+
+	accelerationSettingsName     = "accelerationsettings"
+	accelerationSettingsEndpoint = "domains/{{ .ID }}/acceleration"
+
+	originSettingsName     = "originsettings"
+	originSettingsEndpoint = "domains/{{ .ID }}/origin"
+
+	headerRulesName     = "headerrules"
+	headerRulesEndpoint = "domains/{{ .ID }}/header-rules"
+
+	dnssecName     = "dnssec"
+	dnssecEndpoint = "domains/{{ .ID }}/dns-service/dnssec"
+
+	cachingName     = "caching"
+	cachingEndpoint = "domains/{{ .ID }}/caching"
+
+	sslSettingsName     = "sslsettings"
+	sslSettingsEndpoint = "domains/{{ .ID }}/ssl"
+
+	// IaaS endpoints are relative to the IaaS service
+	regionsName     = "regions"
+	regionsEndpoint = "regions"
+
+	serversName     = "servers"
+	serversEndpoint = "regions/{{ .ID }}/servers"
+
+	imagesName     = "images"
+	imagesEndpoint = "regions/{{ .ID }}/images"
+
+	flavorsName     = "flavors"
+	flavorsEndpoint = "regions/{{ .ID }}/sizes"
+
+	securityGroupsName     = "securitygroups"
+	securityGroupsEndpoint = "regions/{{ .ID }}/securities"
+
+	securityGroupRulesName     = "securitygrouprules"
+	securityGroupRulesEndpoint = "regions/{{ .ID }}/securities/{{ .SecondID }}/rules"
+
+	floatingIPsName     = "floatingips"
+	floatingIPsEndpoint = "regions/{{ .ID }}/float-ips"
+
+	networksName     = "networks"
+	networksEndpoint = "regions/{{ .ID }}/networks"
+
+	subnetsName     = "subnets"
+	subnetsEndpoint = "regions/{{ .ID }}/networks/{{ .SecondID }}/subnets"
 )
 
 // Resource represents a arvancloud API resource
@@ -24,6 +108,7 @@ type Resource struct {
 	endpoint         string
 	isTemplate       bool
 	endpointTemplate *template.Template
+	service          *Service
 	R                func(ctx context.Context) *resty.Request
 	PR               func(ctx context.Context) *resty.Request
 }
@@ -44,7 +129,16 @@ func NewResource(client *Client, name string, endpoint string, useTemplate bool,
 		return client.R(ctx).SetResult(pagedType)
 	}
 
-	return &Resource{name, endpoint, useTemplate, tmpl, r, pr}
+	return &Resource{name, endpoint, useTemplate, tmpl, nil, r, pr}
+}
+
+// NewServiceResource creates a Resource of the service of a product other than the CDN.
+// Its endpoints are absolute URLs under the base URL of the service.
+func NewServiceResource(service *Service, name string, endpoint string, useTemplate bool, singleType interface{}, pagedType interface{}) *Resource {
+	r := NewResource(service.client, name, endpoint, useTemplate, singleType, pagedType)
+	r.service = service
+
+	return r
 }
 
 // Endpoint will return the non-templated endpoint string for resource
@@ -52,5 +146,58 @@ func (r Resource) Endpoint() (string, error) {
 	if r.isTemplate {
 		return "", NewError(fmt.Sprintf("Tried to get endpoint for %s without providing data for template", r.name))
 	}
-	return r.endpoint, nil
+	return r.serviceURL(r.endpoint), nil
+}
+
+// serviceURL returns the absolute URL of endpoint for resources of a service,
+// CDN endpoints are relative to the base URL of the client
+func (r Resource) serviceURL(endpoint string) string {
+	if r.service == nil {
+		return endpoint
+	}
+
+	return r.service.url(endpoint)
+}
+
+// render handles the resource template with the given data. One value is
+// available to the template as .ID, a second one as .SecondID.
+func (r Resource) render(data ...interface{}) (string, error) {
+	if data == nil {
+		return "", NewError("Cannot template endpoint with <nil> data")
+	}
+
+	var substitutedData interface{}
+
+	switch len(data) {
+	case 1:
+		substitutedData = struct{ ID interface{} }{data[0]}
+	case 2:
+		substitutedData = struct {
+			ID       interface{}
+			SecondID interface{}
+		}{data[0], data[1]}
+	default:
+		return "", NewError("Too many arguments to render template (expected 1 or 2)")
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := r.endpointTemplate.Execute(buf, substitutedData); err != nil {
+		return "", NewError(err)
+	}
+
+	return buf.String(), nil
+}
+
+// endpointWithParams will return the rendered endpoint string for the resource with provided parameters
+func (r Resource) endpointWithParams(params ...interface{}) (string, error) {
+	if !r.isTemplate {
+		return r.serviceURL(r.endpoint), nil
+	}
+
+	e, err := r.render(params...)
+	if err != nil {
+		return "", err
+	}
+
+	return r.serviceURL(e), nil
 }
@@ -19,3 +19,20 @@ func TestResourceEndpoint(t *testing.T) {
 		t.Errorf("domains endpoint did not match '%s'", domainsEndpoint)
 	}
 }
+
+func TestResourceEndpointWithParams(t *testing.T) {
+	client := NewClient("MYFAKEAPIKEY")
+
+	if _, err := client.WAFRules.Endpoint(); err == nil {
+		t.Error("expected error when querying templated endpoint without data")
+	}
+
+	e, err := client.WAFRules.endpointWithParams("example.com", "owasp")
+	if err != nil {
+		t.Fatalf("Got error when rendering waf rules endpoint: %s", err)
+	}
+
+	if expected := "domains/example.com/waf/packages/owasp/rules"; e != expected {
+		t.Errorf("waf rules endpoint %q did not match %q", e, expected)
+	}
+}
@@ -0,0 +1,14 @@
+package sdk
+
+// dataResponse is the envelope the Arvancloud API wraps single objects in,
+// e.g. {"data": {...}, "message": "..."}
+type dataResponse struct {
+	Data    interface{} `json:"data"`
+	Message string      `json:"message,omitempty"`
+}
+
+// wrapResult prepares result to be passed to resty's SetResult for an
+// enveloped response. result must be a pointer; it is filled in place.
+func wrapResult(result interface{}) *dataResponse {
+	return &dataResponse{Data: result}
+}
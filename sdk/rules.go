@@ -0,0 +1,34 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// rulePrioritiesPath is appended to the endpoint of an ordered rule type to reorder its rules
+const rulePrioritiesPath = "priorities"
+
+// RulePrioritiesOptions sets the evaluation order of the rules of a Domain.
+// RuleIDs must contain every rule of the type being reordered, first rule first.
+type RulePrioritiesOptions struct {
+	RuleIDs []string `json:"rule_ids"`
+}
+
+// reorderRules applies the full ordering of ruleIDs to the rules of the ordered
+// rule type served by resource for domain
+func (c *Client) reorderRules(ctx context.Context, resource *Resource, domain string, ruleIDs []string) error {
+	e, err := resource.endpointWithParams(domain)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, rulePrioritiesPath)
+
+	bodyData, err := json.Marshal(RulePrioritiesOptions{RuleIDs: ruleIDs})
+	if err != nil {
+		return NewError(err)
+	}
+
+	_, err = coupleAPIErrors(c.R(ctx).SetBody(string(bodyData)).Put(e))
+	return err
+}
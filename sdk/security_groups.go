@@ -0,0 +1,279 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SecurityGroupRuleDirection is whether a SecurityGroupRule allows incoming or outgoing traffic
+type SecurityGroupRuleDirection string
+
+// SecurityGroupRuleDirection enums
+const (
+	SecurityGroupRuleIngress SecurityGroupRuleDirection = "ingress"
+	SecurityGroupRuleEgress  SecurityGroupRuleDirection = "egress"
+)
+
+// SecurityGroupRuleProtocol is the protocol a SecurityGroupRule allows, any when empty
+type SecurityGroupRuleProtocol string
+
+// SecurityGroupRuleProtocol enums
+const (
+	SecurityGroupRuleProtocolAny  SecurityGroupRuleProtocol = ""
+	SecurityGroupRuleProtocolTCP  SecurityGroupRuleProtocol = "tcp"
+	SecurityGroupRuleProtocolUDP  SecurityGroupRuleProtocol = "udp"
+	SecurityGroupRuleProtocolICMP SecurityGroupRuleProtocol = "icmp"
+)
+
+// SecurityGroupRule allows traffic to or from the servers of a SecurityGroup
+type SecurityGroupRule struct {
+	ID        string                     `json:"id"`
+	Direction SecurityGroupRuleDirection `json:"direction"`
+	Protocol  SecurityGroupRuleProtocol  `json:"protocol"`
+	// PortFrom and PortTo are the allowed port range, any port when zero
+	PortFrom int `json:"port_from"`
+	PortTo   int `json:"port_to"`
+	// IP is the CIDR of the remote addresses, any address when empty
+	IP          string `json:"ip"`
+	Description string `json:"description"`
+}
+
+// SecurityGroupRuleCreateOptions fields are those accepted by CreateSecurityGroupRule
+type SecurityGroupRuleCreateOptions struct {
+	Direction   SecurityGroupRuleDirection `json:"direction"`
+	Protocol    SecurityGroupRuleProtocol  `json:"protocol,omitempty"`
+	PortFrom    int                        `json:"port_from,omitempty"`
+	PortTo      int                        `json:"port_to,omitempty"`
+	IP          string                     `json:"ip,omitempty"`
+	Description string                     `json:"description,omitempty"`
+}
+
+// SecurityGroup is a region's firewall, a set of rules applied to the servers it is attached to
+type SecurityGroup struct {
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Rules       []SecurityGroupRule `json:"rules"`
+	// Default is set on the group the servers of a region are attached to on creation
+	Default bool `json:"default"`
+	// ReadOnly groups are managed by Arvancloud and can not be changed
+	ReadOnly bool `json:"readonly"`
+}
+
+// SecurityGroupCreateOptions fields are those accepted by CreateSecurityGroup
+type SecurityGroupCreateOptions struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// SecurityGroupUpdateOptions fields are those accepted by UpdateSecurityGroup.
+// Rules are managed through the SecurityGroupRule methods.
+type SecurityGroupUpdateOptions struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// GetUpdateOptions converts a SecurityGroup to SecurityGroupUpdateOptions for use in UpdateSecurityGroup
+func (g SecurityGroup) GetUpdateOptions() SecurityGroupUpdateOptions {
+	return SecurityGroupUpdateOptions{
+		Name:        g.Name,
+		Description: g.Description,
+	}
+}
+
+// SecurityGroupsPagedResponse represents a paginated SecurityGroup API response
+type SecurityGroupsPagedResponse struct {
+	*PageOptions
+	Data []SecurityGroup `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for SecurityGroups of a region
+func (SecurityGroupsPagedResponse) endpointWithID(c *Client, region string) string {
+	endpoint, err := c.SecurityGroups.endpointWithParams(region)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends SecurityGroups when processing paginated SecurityGroup responses
+func (resp *SecurityGroupsPagedResponse) appendData(r *SecurityGroupsPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// SecurityGroupRulesPagedResponse represents a paginated SecurityGroupRule API response
+type SecurityGroupRulesPagedResponse struct {
+	*PageOptions
+	Data []SecurityGroupRule `json:"data"`
+}
+
+// endpointWithTwoIDs gets the endpoint URL for the SecurityGroupRules of a SecurityGroup
+func (SecurityGroupRulesPagedResponse) endpointWithTwoIDs(c *Client, region, groupID string) string {
+	endpoint, err := c.SecurityGroupRules.endpointWithParams(region, groupID)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends SecurityGroupRules when processing paginated SecurityGroupRule responses
+func (resp *SecurityGroupRulesPagedResponse) appendData(r *SecurityGroupRulesPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListSecurityGroups lists the SecurityGroups of a region
+func (c *Client) ListSecurityGroups(ctx context.Context, region string, opts *ListOptions) ([]SecurityGroup, error) {
+	response := SecurityGroupsPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, region, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetSecurityGroup gets a SecurityGroup of a region
+func (c *Client) GetSecurityGroup(ctx context.Context, region, groupID string) (*SecurityGroup, error) {
+	e, err := c.SecurityGroups.endpointWithParams(region)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, groupID)
+
+	group := &SecurityGroup{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(group)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// CreateSecurityGroup creates a SecurityGroup in a region
+func (c *Client) CreateSecurityGroup(ctx context.Context, region string, createOpts SecurityGroupCreateOptions) (*SecurityGroup, error) {
+	e, err := c.SecurityGroups.endpointWithParams(region)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	group := &SecurityGroup{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(group)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// UpdateSecurityGroup updates a SecurityGroup of a region
+func (c *Client) UpdateSecurityGroup(ctx context.Context, region, groupID string, updateOpts SecurityGroupUpdateOptions) (*SecurityGroup, error) {
+	e, err := c.SecurityGroups.endpointWithParams(region)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, groupID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	group := &SecurityGroup{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(group)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// DeleteSecurityGroup deletes a SecurityGroup of a region
+func (c *Client) DeleteSecurityGroup(ctx context.Context, region, groupID string) error {
+	e, err := c.SecurityGroups.endpointWithParams(region)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, groupID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
+
+// ListSecurityGroupRules lists the SecurityGroupRules of a SecurityGroup
+func (c *Client) ListSecurityGroupRules(ctx context.Context, region, groupID string, opts *ListOptions) ([]SecurityGroupRule, error) {
+	response := SecurityGroupRulesPagedResponse{}
+	err := c.listHelperWithTwoIDs(ctx, &response, region, groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetSecurityGroupRule gets a SecurityGroupRule of a SecurityGroup
+func (c *Client) GetSecurityGroupRule(ctx context.Context, region, groupID, ruleID string) (*SecurityGroupRule, error) {
+	e, err := c.SecurityGroupRules.endpointWithParams(region, groupID)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	rule := &SecurityGroupRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// CreateSecurityGroupRule adds a SecurityGroupRule to a SecurityGroup. Rules can not
+// be updated, replace them by deleting and creating them.
+func (c *Client) CreateSecurityGroupRule(ctx context.Context, region, groupID string, createOpts SecurityGroupRuleCreateOptions) (*SecurityGroupRule, error) {
+	e, err := c.SecurityGroupRules.endpointWithParams(region, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	rule := &SecurityGroupRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// DeleteSecurityGroupRule removes a SecurityGroupRule from a SecurityGroup
+func (c *Client) DeleteSecurityGroupRule(ctx context.Context, region, groupID, ruleID string) error {
+	e, err := c.SecurityGroupRules.endpointWithParams(region, groupID)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
+
+// AddServerSecurityGroup attaches a SecurityGroup to a Server
+func (c *Client) AddServerSecurityGroup(ctx context.Context, region, serverID, groupID string) error {
+	return c.serverAction(ctx, region, serverID, "add-security-group", struct {
+		SecurityGroupID string `json:"security_group_id"`
+	}{groupID})
+}
+
+// RemoveServerSecurityGroup detaches a SecurityGroup from a Server
+func (c *Client) RemoveServerSecurityGroup(ctx context.Context, region, serverID, groupID string) error {
+	return c.serverAction(ctx, region, serverID, "remove-security-group", struct {
+		SecurityGroupID string `json:"security_group_id"`
+	}{groupID})
+}
@@ -0,0 +1,78 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSecurityGroups(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		switch r.URL.Path {
+		case "/ecc/v1/regions/ir-thr-c2/securities":
+			writeJSON(w, http.StatusCreated, `{"data":{"id":"sg1","name":"web"}}`)
+		case "/ecc/v1/regions/ir-thr-c2/securities/sg1/rules":
+			if r.Method == http.MethodGet {
+				writeJSON(w, http.StatusOK, `{"data":[{"id":"r1","direction":"ingress","protocol":"tcp","port_from":443,"port_to":443}]}`)
+				return
+			}
+			writeJSON(w, http.StatusCreated, `{"data":{"id":"r1","direction":"ingress","protocol":"tcp","port_from":443,"port_to":443}}`)
+		default:
+			writeJSON(w, http.StatusOK, `{"message":"ok"}`)
+		}
+	})
+
+	ctx := context.Background()
+
+	group, err := client.CreateSecurityGroup(ctx, "ir-thr-c2", SecurityGroupCreateOptions{Name: "web"})
+	if err != nil {
+		t.Fatalf("Error creating security group: %s", err)
+	}
+
+	rule, err := client.CreateSecurityGroupRule(ctx, "ir-thr-c2", group.ID, SecurityGroupRuleCreateOptions{
+		Direction: SecurityGroupRuleIngress,
+		Protocol:  SecurityGroupRuleProtocolTCP,
+		PortFrom:  443,
+		PortTo:    443,
+	})
+	if err != nil {
+		t.Fatalf("Error creating security group rule: %s", err)
+	}
+
+	rules, err := client.ListSecurityGroupRules(ctx, "ir-thr-c2", group.ID, nil)
+	if err != nil {
+		t.Fatalf("Error listing security group rules: %s", err)
+	}
+
+	if !cmp.Equal(rules, []SecurityGroupRule{*rule}) {
+		t.Error(cmp.Diff(rules, []SecurityGroupRule{*rule}))
+	}
+
+	if err := client.AddServerSecurityGroup(ctx, "ir-thr-c2", "s1", group.ID); err != nil {
+		t.Fatalf("Error adding security group to server: %s", err)
+	}
+
+	if err := client.DeleteSecurityGroupRule(ctx, "ir-thr-c2", group.ID, rule.ID); err != nil {
+		t.Fatalf("Error deleting security group rule: %s", err)
+	}
+
+	expected := []string{
+		`POST /ecc/v1/regions/ir-thr-c2/securities {"name":"web"}`,
+		`POST /ecc/v1/regions/ir-thr-c2/securities/sg1/rules {"direction":"ingress","protocol":"tcp","port_from":443,"port_to":443}`,
+		"GET /ecc/v1/regions/ir-thr-c2/securities/sg1/rules ",
+		`POST /ecc/v1/regions/ir-thr-c2/servers/s1/add-security-group {"security_group_id":"sg1"}`,
+		"DELETE /ecc/v1/regions/ir-thr-c2/securities/sg1/rules/r1 ",
+	}
+	if !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+}
@@ -0,0 +1,191 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ServerStatus is the state of a Server
+type ServerStatus string
+
+// ServerStatus enums
+const (
+	ServerStatusBuild   ServerStatus = "BUILD"
+	ServerStatusActive  ServerStatus = "ACTIVE"
+	ServerStatusShutoff ServerStatus = "SHUTOFF"
+	ServerStatusReboot  ServerStatus = "REBOOT"
+	ServerStatusRebuild ServerStatus = "REBUILD"
+	ServerStatusResize  ServerStatus = "RESIZE"
+	ServerStatusError   ServerStatus = "ERROR"
+	ServerStatusDeleted ServerStatus = "DELETED"
+)
+
+// ServerAddress is an IP address of a Server on one of its networks
+type ServerAddress struct {
+	Address  string `json:"addr"`
+	MAC      string `json:"mac"`
+	Version  int    `json:"version"`
+	IsPublic bool   `json:"is_public"`
+}
+
+// Server is an IaaS virtual machine
+type Server struct {
+	ID     string       `json:"id"`
+	Name   string       `json:"name"`
+	Status ServerStatus `json:"status"`
+	Flavor Flavor       `json:"flavor"`
+	Image  Image        `json:"image"`
+	// Addresses are the addresses of the server by network name
+	Addresses      map[string][]ServerAddress `json:"addresses"`
+	SecurityGroups []SecurityGroup            `json:"security_groups"`
+	KeyName        string                     `json:"key_name"`
+	Created        time.Time                  `json:"created"`
+}
+
+// ServerCreateOptions fields are those accepted by CreateServer
+type ServerCreateOptions struct {
+	Name     string `json:"name"`
+	FlavorID string `json:"flavor_id"`
+	ImageID  string `json:"image_id"`
+	// DiskSize is the size of the root disk in GB, the size of the flavor when empty
+	DiskSize   int      `json:"disk_size,omitempty"`
+	NetworkIDs []string `json:"network_ids,omitempty"`
+	// SecurityGroupIDs are attached to the server, the default group of the region when empty
+	SecurityGroupIDs []string `json:"security_groups,omitempty"`
+	KeyName          string   `json:"key_name,omitempty"`
+	// InitScript is run by cloud-init on first boot
+	InitScript string `json:"init_script,omitempty"`
+}
+
+// ServersPagedResponse represents a paginated Server API response
+type ServersPagedResponse struct {
+	*PageOptions
+	Data []Server `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for Servers of a region
+func (ServersPagedResponse) endpointWithID(c *Client, region string) string {
+	endpoint, err := c.Servers.endpointWithParams(region)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends Servers when processing paginated Server responses
+func (resp *ServersPagedResponse) appendData(r *ServersPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListServers lists the Servers of a region
+func (c *Client) ListServers(ctx context.Context, region string, opts *ListOptions) ([]Server, error) {
+	response := ServersPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, region, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetServer gets a Server of a region
+func (c *Client) GetServer(ctx context.Context, region, serverID string) (*Server, error) {
+	e, err := c.Servers.endpointWithParams(region)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, serverID)
+
+	server := &Server{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(server)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return server, nil
+}
+
+// CreateServer creates a Server in a region. The server is built asynchronously,
+// see WaitForServerStatus.
+func (c *Client) CreateServer(ctx context.Context, region string, createOpts ServerCreateOptions) (*Server, error) {
+	e, err := c.Servers.endpointWithParams(region)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	server := &Server{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(server)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return server, nil
+}
+
+// DeleteServer deletes a Server of a region
+func (c *Client) DeleteServer(ctx context.Context, region, serverID string) error {
+	e, err := c.Servers.endpointWithParams(region)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, serverID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
+
+// serverAction posts an action, e.g. power-on, to a Server with an optional body
+func (c *Client) serverAction(ctx context.Context, region, serverID, action string, body interface{}) error {
+	e, err := c.Servers.endpointWithParams(region)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s/%s", e, serverID, action)
+
+	req := c.R(ctx)
+	if body != nil {
+		bodyData, err := json.Marshal(body)
+		if err != nil {
+			return NewError(err)
+		}
+		req.SetBody(string(bodyData))
+	}
+
+	_, err = coupleAPIErrors(req.Post(e))
+	return err
+}
+
+// StartServer powers on a Server
+func (c *Client) StartServer(ctx context.Context, region, serverID string) error {
+	return c.serverAction(ctx, region, serverID, "power-on", nil)
+}
+
+// StopServer powers off a Server
+func (c *Client) StopServer(ctx context.Context, region, serverID string) error {
+	return c.serverAction(ctx, region, serverID, "power-off", nil)
+}
+
+// RebootServer reboots a Server
+func (c *Client) RebootServer(ctx context.Context, region, serverID string) error {
+	return c.serverAction(ctx, region, serverID, "reboot", nil)
+}
+
+// RebuildServer reinstalls a Server from an Image, erasing its disk
+func (c *Client) RebuildServer(ctx context.Context, region, serverID, imageID string) error {
+	return c.serverAction(ctx, region, serverID, "rebuild", struct {
+		ImageID string `json:"image_id"`
+	}{imageID})
+}
+
+// ResizeServer changes the Flavor of a Server
+func (c *Client) ResizeServer(ctx context.Context, region, serverID, flavorID string) error {
+	return c.serverAction(ctx, region, serverID, "resize", struct {
+		FlavorID string `json:"flavor_id"`
+	}{flavorID})
+}
@@ -0,0 +1,129 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListServers(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ecc/v1/regions/ir-thr-c2/servers" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			page = "1"
+		}
+		writeJSON(w, http.StatusOK, fmt.Sprintf(`{"data":[{"id":"s%s","status":"ACTIVE"}],"meta":{"current_page":%s,"last_page":2,"total":2}}`, page, page))
+	})
+
+	servers, err := client.ListServers(context.Background(), "ir-thr-c2", nil)
+	if err != nil {
+		t.Fatalf("Error listing servers: %s", err)
+	}
+
+	expected := []Server{{ID: "s1", Status: ServerStatusActive}, {ID: "s2", Status: ServerStatusActive}}
+	if !cmp.Equal(servers, expected) {
+		t.Error(cmp.Diff(servers, expected))
+	}
+}
+
+func TestListRegions(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, fmt.Sprintf("%s %s?%s", r.Method, r.URL.Path, r.URL.RawQuery))
+
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			writeJSON(w, http.StatusOK, `{"data":[{"code":"ir-thr-c2","country":"Iran","city":"Tehran","dc":"Simin","visible":true,"default":true}],"meta":{"current_page":1,"last_page":2,"total":2}}`)
+		default:
+			writeJSON(w, http.StatusOK, `{"data":[{"code":"ir-tbz-dc1","country":"Iran","city":"Tabriz","dc":"Shahriar","visible":true}],"meta":{"current_page":2,"last_page":2,"total":2}}`)
+		}
+	})
+
+	regions, err := client.ListRegions(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Error listing regions: %s", err)
+	}
+
+	expected := []Region{
+		{Code: "ir-thr-c2", Country: "Iran", City: "Tehran", DataCenter: "Simin", Visible: true, Default: true},
+		{Code: "ir-tbz-dc1", Country: "Iran", City: "Tabriz", DataCenter: "Shahriar", Visible: true},
+	}
+	if !cmp.Equal(regions, expected) {
+		t.Error(cmp.Diff(regions, expected))
+	}
+
+	expectedRequests := []string{"GET /ecc/v1/regions?", "GET /ecc/v1/regions?page=2"}
+	if !cmp.Equal(requests, expectedRequests) {
+		t.Error(cmp.Diff(requests, expectedRequests))
+	}
+}
+
+func TestServerActions(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+		writeJSON(w, http.StatusOK, `{"message":"ok"}`)
+	})
+
+	ctx := context.Background()
+	for _, action := range []func() error{
+		func() error { return client.StartServer(ctx, "ir-thr-c2", "s1") },
+		func() error { return client.StopServer(ctx, "ir-thr-c2", "s1") },
+		func() error { return client.RebootServer(ctx, "ir-thr-c2", "s1") },
+		func() error { return client.RebuildServer(ctx, "ir-thr-c2", "s1", "ubuntu-22.04") },
+		func() error { return client.ResizeServer(ctx, "ir-thr-c2", "s1", "g1-4-2-0") },
+		func() error { return client.DeleteServer(ctx, "ir-thr-c2", "s1") },
+	} {
+		if err := action(); err != nil {
+			t.Fatalf("Error running server action: %s", err)
+		}
+	}
+
+	expected := []string{
+		"POST /ecc/v1/regions/ir-thr-c2/servers/s1/power-on ",
+		"POST /ecc/v1/regions/ir-thr-c2/servers/s1/power-off ",
+		"POST /ecc/v1/regions/ir-thr-c2/servers/s1/reboot ",
+		`POST /ecc/v1/regions/ir-thr-c2/servers/s1/rebuild {"image_id":"ubuntu-22.04"}`,
+		`POST /ecc/v1/regions/ir-thr-c2/servers/s1/resize {"flavor_id":"g1-4-2-0"}`,
+		"DELETE /ecc/v1/regions/ir-thr-c2/servers/s1 ",
+	}
+	if !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+}
+
+func TestWaitForServerStatus(t *testing.T) {
+	statuses := []ServerStatus{ServerStatusBuild, ServerStatusBuild, ServerStatusActive, ServerStatusError}
+	polls := 0
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[polls]
+		polls++
+		writeJSON(w, http.StatusOK, fmt.Sprintf(`{"data":{"id":"s1","status":"%s"}}`, status))
+	})
+	client.SetPollDelay(1)
+
+	server, err := client.WaitForServerStatus(context.Background(), "ir-thr-c2", "s1", ServerStatusActive, 5)
+	if err != nil {
+		t.Fatalf("Error waiting for server: %s", err)
+	}
+
+	if server.Status != ServerStatusActive || polls != 3 {
+		t.Errorf("expected an active server after 3 polls, got %s after %d", server.Status, polls)
+	}
+
+	if _, err := client.WaitForServerStatus(context.Background(), "ir-thr-c2", "s1", ServerStatusShutoff, 5); err == nil {
+		t.Error("expected an error once the server errored")
+	}
+}
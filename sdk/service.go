@@ -0,0 +1,111 @@
+package sdk
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Product is an Arvancloud product served by the API under its own base path
+type Product string
+
+// Product enums
+const (
+	ProductCDN               Product = "cdn"
+	ProductIaaS              Product = "ecc"
+	ProductVOD               Product = "vod"
+	ProductLive              Product = "live"
+	ProductContainerRegistry Product = "cr"
+)
+
+const (
+	// IaaSAPIVersion Arvancloud IaaS (ECC) API base path and version
+	IaaSAPIVersion = "ecc/v1"
+	// VODAPIVersion Arvancloud VOD API base path and version
+	VODAPIVersion = "vod/2.0"
+	// LiveAPIVersion Arvancloud Live API base path and version
+	LiveAPIVersion = "live/2.0"
+	// ContainerRegistryAPIVersion Arvancloud Container Registry API base path and version
+	ContainerRegistryAPIVersion = "cr/v1"
+)
+
+// Service is the client of one Arvancloud product. The services of a Client share its
+// authentication, retries, transport and logging, each has its own base path and version.
+type Service struct {
+	client   *Client
+	product  Product
+	apiProto string
+	host     string
+	basePath string
+}
+
+func newService(client *Client, product Product, basePath string) *Service {
+	return &Service{
+		client:   client,
+		product:  product,
+		apiProto: APIProto,
+		host:     APIHost,
+		basePath: basePath,
+	}
+}
+
+// Product returns the product of the service
+func (s *Service) Product() Product {
+	return s.product
+}
+
+// BaseURL returns the URL the endpoints of the service are relative to
+func (s *Service) BaseURL() string {
+	return fmt.Sprintf("%s://%s/%s", s.apiProto, s.host, s.basePath)
+}
+
+// SetBasePath sets the base path of the service, its product path and API version, e.g. "ecc/v1"
+func (s *Service) SetBasePath(basePath string) *Service {
+	s.basePath = strings.Trim(basePath, "/")
+
+	if s.product == ProductCDN {
+		s.client.updateHostURL()
+	}
+
+	return s
+}
+
+// url returns the absolute URL of an endpoint of the service
+func (s *Service) url(endpoint string) string {
+	return fmt.Sprintf("%s/%s", s.BaseURL(), endpoint)
+}
+
+// nolint
+func addServices(client *Client) {
+	services := map[Product]*Service{
+		ProductCDN:               newService(client, ProductCDN, APIVersion),
+		ProductIaaS:              newService(client, ProductIaaS, IaaSAPIVersion),
+		ProductVOD:               newService(client, ProductVOD, VODAPIVersion),
+		ProductLive:              newService(client, ProductLive, LiveAPIVersion),
+		ProductContainerRegistry: newService(client, ProductContainerRegistry, ContainerRegistryAPIVersion),
+	}
+
+	client.services = services
+
+	client.CDN = services[ProductCDN]
+	client.IaaS = services[ProductIaaS]
+	client.VOD = services[ProductVOD]
+	client.Live = services[ProductLive]
+	client.ContainerRegistry = services[ProductContainerRegistry]
+}
+
+// relativeEndpoint returns rawURL relative to hostURL, the CDN base URL, or relative to
+// the API host for the other products, e.g. "ecc/v1/regions/ir-thr-c2/servers"
+func relativeEndpoint(hostURL, rawURL string) string {
+	base := strings.TrimSuffix(hostURL, "/")
+	if rest := strings.TrimPrefix(rawURL, base); rest != rawURL && (rest == "" || rest[0] == '/' || rest[0] == '?') {
+		return strings.TrimPrefix(rest, "/")
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	return strings.TrimPrefix(u.RequestURI(), "/")
+}
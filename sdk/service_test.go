@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient_services(t *testing.T) {
+	t.Setenv(APIHostVar, "")
+	t.Setenv(APIVersionVar, "")
+
+	client := NewClient("")
+	client.SetBaseURL("http://api.example.com")
+	client.SetAPIVersion("cdn/5.0")
+	client.VOD.SetBasePath("/vod/3.0/")
+
+	expected := map[Product]string{
+		ProductCDN:               "http://api.example.com/cdn/5.0",
+		ProductIaaS:              "http://api.example.com/ecc/v1",
+		ProductVOD:               "http://api.example.com/vod/3.0",
+		ProductLive:              "http://api.example.com/live/2.0",
+		ProductContainerRegistry: "http://api.example.com/cr/v1",
+	}
+
+	for _, s := range []*Service{client.CDN, client.IaaS, client.VOD, client.Live, client.ContainerRegistry} {
+		if s.BaseURL() != expected[s.Product()] {
+			t.Errorf("%s: expected base URL %q, got %q", s.Product(), expected[s.Product()], s.BaseURL())
+		}
+	}
+
+	if client.resty.HostURL != expected[ProductCDN] {
+		t.Errorf("expected the client to use the CDN base URL, got %q", client.resty.HostURL)
+	}
+}
+
+func TestNewServiceResource(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Header.Get("Authorization")+" "+r.URL.Path)
+		writeJSON(w, http.StatusOK, `{"data":{}}`)
+	})
+
+	resource := NewServiceResource(client.IaaS, "regions", "regions/{{ .ID }}/servers", true, Account{}, nil)
+
+	e, err := resource.endpointWithParams("ir-thr-c2")
+	if err != nil {
+		t.Fatalf("Error rendering endpoint: %s", err)
+	}
+
+	if expected := client.IaaS.BaseURL() + "/regions/ir-thr-c2/servers"; e != expected {
+		t.Errorf("expected endpoint %q, got %q", expected, e)
+	}
+
+	if _, err := coupleAPIErrors(resource.R(context.Background()).Get(e)); err != nil {
+		t.Fatalf("Error requesting %s: %s", e, err)
+	}
+
+	if _, err := coupleAPIErrors(client.R(context.Background()).Get("domains")); err != nil {
+		t.Fatalf("Error requesting domains: %s", err)
+	}
+
+	expected := []string{"MYFAKEAPIKEY /ecc/v1/regions/ir-thr-c2/servers", "MYFAKEAPIKEY /cdn/4.0/domains"}
+	if !cmp.Equal(requests, expected) {
+		t.Error(cmp.Diff(requests, expected))
+	}
+}
+
+func TestRelativeEndpoint(t *testing.T) {
+	hostURL := "https://napi.arvancloud.com/cdn/4.0"
+
+	for rawURL, expected := range map[string]string{
+		hostURL + "/domains?page=2": "domains?page=2",
+		hostURL:                     "",
+		"https://napi.arvancloud.com/cdn/4.01/domains":                 "cdn/4.01/domains",
+		"https://napi.arvancloud.com/ecc/v1/regions/ir-thr-c2/servers": "ecc/v1/regions/ir-thr-c2/servers",
+	} {
+		if e := relativeEndpoint(hostURL, rawURL); e != expected {
+			t.Errorf("%s: expected %q, got %q", rawURL, expected, e)
+		}
+	}
+}
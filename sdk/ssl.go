@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// SSLType is which certificate a Domain serves HTTPS with
+type SSLType string
+
+// SSLType enums
+const (
+	SSLTypeOff    SSLType = "off"
+	SSLTypeArvan  SSLType = "arvan"
+	SSLTypeCustom SSLType = "custom"
+)
+
+// SSLCertificate is a certificate of a Domain, issued by Arvancloud or uploaded
+type SSLCertificate struct {
+	ID                string    `json:"id"`
+	Type              SSLType   `json:"type"`
+	Active            bool      `json:"active"`
+	CommonName        string    `json:"common_name"`
+	AltNames          []string  `json:"alt_names"`
+	Issuer            string    `json:"issuer"`
+	FingerprintSHA256 string    `json:"fingerprint_sha256"`
+	ExpiredAt         time.Time `json:"expired_at"`
+}
+
+// SSLSettings represents the HTTPS settings of a Domain
+type SSLSettings struct {
+	Type    SSLType `json:"ssl_type"`
+	Enabled bool    `json:"ssl_status"`
+	// HTTPSRedirect redirects HTTP requests to HTTPS
+	HTTPSRedirect bool `json:"https_redirect"`
+	HSTS          bool `json:"hsts_status"`
+	// HSTSMaxAge is the max-age, in seconds, of the Strict-Transport-Security header
+	HSTSMaxAge   int              `json:"hsts_max_age"`
+	Certificates []SSLCertificate `json:"certificates"`
+}
+
+// SSLSettingsUpdateOptions fields are those accepted by UpdateSSLSettings.
+// Nil and empty fields are not changed.
+type SSLSettingsUpdateOptions struct {
+	Type          SSLType `json:"ssl_type,omitempty"`
+	HTTPSRedirect *bool   `json:"https_redirect,omitempty"`
+	HSTS          *bool   `json:"hsts_status,omitempty"`
+	HSTSMaxAge    int     `json:"hsts_max_age,omitempty"`
+}
+
+// GetSSLSettings gets the HTTPS settings and certificates of a Domain
+func (c *Client) GetSSLSettings(ctx context.Context, domain string) (*SSLSettings, error) {
+	e, err := c.SSLSettings.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &SSLSettings{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(settings)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// UpdateSSLSettings updates the HTTPS settings of a Domain and returns the resulting settings
+func (c *Client) UpdateSSLSettings(ctx context.Context, domain string, updateOpts SSLSettingsUpdateOptions) (*SSLSettings, error) {
+	e, err := c.SSLSettings.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	settings := &SSLSettings{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(settings)).SetBody(string(bodyData)).Patch(e)); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSSLSettings(t *testing.T) {
+	var requests []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		if r.Method == http.MethodGet {
+			writeJSON(w, http.StatusOK, `{"data":{"ssl_type":"arvan","ssl_status":true,"https_redirect":false,"certificates":[{"id":"c1","type":"arvan","active":true,"common_name":"example.com","alt_names":["*.example.com"]}]}}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"data":{"ssl_type":"arvan","ssl_status":true,"https_redirect":true,"hsts_status":true,"hsts_max_age":31536000}}`)
+	})
+
+	settings, err := client.GetSSLSettings(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("Error getting SSL settings: %s", err)
+	}
+
+	expected := &SSLSettings{
+		Type:    SSLTypeArvan,
+		Enabled: true,
+		Certificates: []SSLCertificate{
+			{ID: "c1", Type: SSLTypeArvan, Active: true, CommonName: "example.com", AltNames: []string{"*.example.com"}},
+		},
+	}
+	if !cmp.Equal(settings, expected) {
+		t.Error(cmp.Diff(settings, expected))
+	}
+
+	enabled := true
+	settings, err = client.UpdateSSLSettings(context.Background(), "example.com", SSLSettingsUpdateOptions{
+		HTTPSRedirect: &enabled,
+		HSTS:          &enabled,
+		HSTSMaxAge:    31536000,
+	})
+	if err != nil {
+		t.Fatalf("Error updating SSL settings: %s", err)
+	}
+
+	if !settings.HTTPSRedirect || !settings.HSTS || settings.HSTSMaxAge != 31536000 {
+		t.Errorf("unexpected SSL settings %#v", settings)
+	}
+
+	expectedRequests := []string{
+		"GET /cdn/4.0/domains/example.com/ssl ",
+		`PATCH /cdn/4.0/domains/example.com/ssl {"https_redirect":true,"hsts_status":true,"hsts_max_age":31536000}`,
+	}
+	if !cmp.Equal(requests, expectedRequests) {
+		t.Error(cmp.Diff(requests, expectedRequests))
+	}
+}
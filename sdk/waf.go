@@ -0,0 +1,338 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// WAFSensitivity is the level at which a WAF package inspects requests
+type WAFSensitivity string
+
+// WAFSensitivity enums
+const (
+	WAFSensitivityLow    WAFSensitivity = "low"
+	WAFSensitivityMedium WAFSensitivity = "medium"
+	WAFSensitivityHigh   WAFSensitivity = "high"
+)
+
+// WAFAction is what the WAF does with a request matching a rule
+type WAFAction string
+
+// WAFAction enums
+const (
+	WAFActionBlock WAFAction = "block"
+	WAFActionLog   WAFAction = "log"
+)
+
+// WAFPackage represents a set of WAF rules that can be enabled on a Domain.
+// Available when Domain.Features.PackagesForWaf is set.
+type WAFPackage struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Enabled     bool           `json:"enabled"`
+	Sensitivity WAFSensitivity `json:"sensitivity"`
+	Action      WAFAction      `json:"action"`
+	RulesCount  int            `json:"rules_count"`
+}
+
+// WAFPackageUpdateOptions fields are those accepted by UpdateWAFPackage
+type WAFPackageUpdateOptions struct {
+	Enabled     *bool          `json:"enabled,omitempty"`
+	Sensitivity WAFSensitivity `json:"sensitivity,omitempty"`
+	Action      WAFAction      `json:"action,omitempty"`
+}
+
+// WAFRule represents a single rule of a WAFPackage
+type WAFRule struct {
+	ID          string    `json:"id"`
+	PackageID   string    `json:"package_id"`
+	Description string    `json:"description"`
+	Enabled     bool      `json:"enabled"`
+	Action      WAFAction `json:"action"`
+}
+
+// WAFRuleUpdateOptions fields are those accepted by UpdateWAFRule
+type WAFRuleUpdateOptions struct {
+	Enabled *bool     `json:"enabled,omitempty"`
+	Action  WAFAction `json:"action,omitempty"`
+}
+
+// WAFExclusion skips WAF packages or rules for requests to a path
+type WAFExclusion struct {
+	ID          string    `json:"id"`
+	Path        string    `json:"path"`
+	PackageIDs  []string  `json:"package_ids"`
+	RuleIDs     []string  `json:"rule_ids"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// WAFExclusionCreateOptions fields are those accepted by CreateWAFExclusion
+type WAFExclusionCreateOptions struct {
+	Path        string   `json:"path"`
+	PackageIDs  []string `json:"package_ids,omitempty"`
+	RuleIDs     []string `json:"rule_ids,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+// WAFExclusionUpdateOptions fields are those accepted by UpdateWAFExclusion.
+// Nil and empty fields are left unchanged.
+type WAFExclusionUpdateOptions struct {
+	Path string `json:"path,omitempty"`
+	// PackageIDs and RuleIDs are left unchanged when nil, point to an empty slice to clear them
+	PackageIDs  *[]string `json:"package_ids,omitempty"`
+	RuleIDs     *[]string `json:"rule_ids,omitempty"`
+	Description string    `json:"description,omitempty"`
+}
+
+// GetUpdateOptions converts a WAFExclusion to WAFExclusionUpdateOptions for use in UpdateWAFExclusion
+func (e WAFExclusion) GetUpdateOptions() WAFExclusionUpdateOptions {
+	packageIDs := append([]string{}, e.PackageIDs...)
+	ruleIDs := append([]string{}, e.RuleIDs...)
+
+	return WAFExclusionUpdateOptions{
+		Path:        e.Path,
+		PackageIDs:  &packageIDs,
+		RuleIDs:     &ruleIDs,
+		Description: e.Description,
+	}
+}
+
+// WAFPackagesPagedResponse represents a paginated WAFPackage API response
+type WAFPackagesPagedResponse struct {
+	*PageOptions
+	Data []WAFPackage `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for WAFPackages of a Domain
+func (WAFPackagesPagedResponse) endpointWithID(c *Client, domain string) string {
+	endpoint, err := c.WAFPackages.endpointWithParams(domain)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends WAFPackages when processing paginated WAFPackage responses
+func (resp *WAFPackagesPagedResponse) appendData(r *WAFPackagesPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// WAFRulesPagedResponse represents a paginated WAFRule API response
+type WAFRulesPagedResponse struct {
+	*PageOptions
+	Data []WAFRule `json:"data"`
+}
+
+// endpointWithTwoIDs gets the endpoint URL for the WAFRules of a WAFPackage
+func (WAFRulesPagedResponse) endpointWithTwoIDs(c *Client, domain, packageID string) string {
+	endpoint, err := c.WAFRules.endpointWithParams(domain, packageID)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends WAFRules when processing paginated WAFRule responses
+func (resp *WAFRulesPagedResponse) appendData(r *WAFRulesPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// WAFExclusionsPagedResponse represents a paginated WAFExclusion API response
+type WAFExclusionsPagedResponse struct {
+	*PageOptions
+	Data []WAFExclusion `json:"data"`
+}
+
+// endpointWithID gets the endpoint URL for WAFExclusions of a Domain
+func (WAFExclusionsPagedResponse) endpointWithID(c *Client, domain string) string {
+	endpoint, err := c.WAFExclusions.endpointWithParams(domain)
+	if err != nil {
+		panic(err)
+	}
+
+	return endpoint
+}
+
+// appendData appends WAFExclusions when processing paginated WAFExclusion responses
+func (resp *WAFExclusionsPagedResponse) appendData(r *WAFExclusionsPagedResponse) {
+	resp.Data = append(resp.Data, r.Data...)
+}
+
+// ListWAFPackages lists the WAFPackages available to a Domain
+func (c *Client) ListWAFPackages(ctx context.Context, domain string, opts *ListOptions) ([]WAFPackage, error) {
+	response := WAFPackagesPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, domain, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetWAFPackage gets a WAFPackage of a Domain
+func (c *Client) GetWAFPackage(ctx context.Context, domain, packageID string) (*WAFPackage, error) {
+	e, err := c.WAFPackages.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, packageID)
+
+	pkg := &WAFPackage{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(pkg)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return pkg, nil
+}
+
+// UpdateWAFPackage updates the state, sensitivity or action of a WAFPackage
+func (c *Client) UpdateWAFPackage(ctx context.Context, domain, packageID string, updateOpts WAFPackageUpdateOptions) (*WAFPackage, error) {
+	e, err := c.WAFPackages.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, packageID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	pkg := &WAFPackage{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(pkg)).SetBody(string(bodyData)).Patch(e)); err != nil {
+		return nil, err
+	}
+
+	return pkg, nil
+}
+
+// EnableWAFPackage enables a WAFPackage on a Domain
+func (c *Client) EnableWAFPackage(ctx context.Context, domain, packageID string) (*WAFPackage, error) {
+	enabled := true
+	return c.UpdateWAFPackage(ctx, domain, packageID, WAFPackageUpdateOptions{Enabled: &enabled})
+}
+
+// DisableWAFPackage disables a WAFPackage on a Domain
+func (c *Client) DisableWAFPackage(ctx context.Context, domain, packageID string) (*WAFPackage, error) {
+	enabled := false
+	return c.UpdateWAFPackage(ctx, domain, packageID, WAFPackageUpdateOptions{Enabled: &enabled})
+}
+
+// ListWAFRules lists the WAFRules of a WAFPackage
+func (c *Client) ListWAFRules(ctx context.Context, domain, packageID string, opts *ListOptions) ([]WAFRule, error) {
+	response := WAFRulesPagedResponse{}
+	err := c.listHelperWithTwoIDs(ctx, &response, domain, packageID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateWAFRule toggles a WAFRule or changes its action
+func (c *Client) UpdateWAFRule(ctx context.Context, domain, packageID, ruleID string, updateOpts WAFRuleUpdateOptions) (*WAFRule, error) {
+	e, err := c.WAFRules.endpointWithParams(domain, packageID)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, ruleID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	rule := &WAFRule{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(rule)).SetBody(string(bodyData)).Patch(e)); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// ListWAFExclusions lists the WAFExclusions of a Domain
+func (c *Client) ListWAFExclusions(ctx context.Context, domain string, opts *ListOptions) ([]WAFExclusion, error) {
+	response := WAFExclusionsPagedResponse{}
+	err := c.listHelperWithID(ctx, &response, domain, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetWAFExclusion gets a WAFExclusion of a Domain
+func (c *Client) GetWAFExclusion(ctx context.Context, domain, exclusionID string) (*WAFExclusion, error) {
+	e, err := c.WAFExclusions.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, exclusionID)
+
+	exclusion := &WAFExclusion{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(exclusion)).Get(e)); err != nil {
+		return nil, err
+	}
+
+	return exclusion, nil
+}
+
+// CreateWAFExclusion creates a WAFExclusion for a path of a Domain
+func (c *Client) CreateWAFExclusion(ctx context.Context, domain string, createOpts WAFExclusionCreateOptions) (*WAFExclusion, error) {
+	e, err := c.WAFExclusions.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyData, err := json.Marshal(createOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	exclusion := &WAFExclusion{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(exclusion)).SetBody(string(bodyData)).Post(e)); err != nil {
+		return nil, err
+	}
+
+	return exclusion, nil
+}
+
+// UpdateWAFExclusion updates a WAFExclusion of a Domain
+func (c *Client) UpdateWAFExclusion(ctx context.Context, domain, exclusionID string, updateOpts WAFExclusionUpdateOptions) (*WAFExclusion, error) {
+	e, err := c.WAFExclusions.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/%s", e, exclusionID)
+
+	bodyData, err := json.Marshal(updateOpts)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	exclusion := &WAFExclusion{}
+	if _, err := coupleAPIErrors(c.R(ctx).SetResult(wrapResult(exclusion)).SetBody(string(bodyData)).Put(e)); err != nil {
+		return nil, err
+	}
+
+	return exclusion, nil
+}
+
+// DeleteWAFExclusion deletes a WAFExclusion of a Domain
+func (c *Client) DeleteWAFExclusion(ctx context.Context, domain, exclusionID string) error {
+	e, err := c.WAFExclusions.endpointWithParams(domain)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/%s", e, exclusionID)
+
+	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
@@ -0,0 +1,136 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListWAFPackages_allPages(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cdn/4.0/domains/example.com/waf/packages" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			writeJSON(w, http.StatusOK, `{"data":[{"id":"owasp","enabled":true}],"meta":{"current_page":1,"last_page":2,"total":2}}`)
+		case "2":
+			writeJSON(w, http.StatusOK, `{"data":[{"id":"wordpress"}],"meta":{"current_page":2,"last_page":2,"total":2}}`)
+		}
+	})
+
+	packages, err := client.ListWAFPackages(context.Background(), "example.com", nil)
+	if err != nil {
+		t.Fatalf("Error listing waf packages: %s", err)
+	}
+
+	expected := []WAFPackage{{ID: "owasp", Enabled: true}, {ID: "wordpress"}}
+	if !cmp.Equal(packages, expected) {
+		t.Error(cmp.Diff(packages, expected))
+	}
+}
+
+func TestDisableWAFPackage(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/cdn/4.0/domains/example.com/waf/packages/owasp" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		var opts map[string]interface{}
+		if err := json.Unmarshal(body, &opts); err != nil {
+			t.Fatal(err)
+		}
+
+		if enabled, ok := opts["enabled"]; !ok || enabled != false {
+			t.Errorf("expected enabled=false in body, got %s", body)
+		}
+
+		writeJSON(w, http.StatusOK, `{"data":{"id":"owasp","enabled":false},"message":"updated"}`)
+	})
+
+	pkg, err := client.DisableWAFPackage(context.Background(), "example.com", "owasp")
+	if err != nil {
+		t.Fatalf("Error disabling waf package: %s", err)
+	}
+
+	if pkg.ID != "owasp" || pkg.Enabled {
+		t.Errorf("unexpected package %#v", pkg)
+	}
+}
+
+func TestCreateWAFExclusion_apiError(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusUnprocessableEntity, `{"errors":[{"field":"path","reason":"is required"}]}`)
+	})
+
+	_, err := client.CreateWAFExclusion(context.Background(), "example.com", WAFExclusionCreateOptions{})
+	if err == nil {
+		t.Fatal("expected error creating waf exclusion")
+	}
+
+	if apiErr, ok := err.(*Error); !ok || apiErr.Code != http.StatusUnprocessableEntity {
+		t.Errorf("unexpected error %#v", err)
+	}
+}
+
+func TestUpdateWAFExclusion_partial(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/cdn/4.0/domains/example.com/waf/exclusions/e1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"description":"static assets"}`; string(body) != expected {
+			t.Errorf("expected body %s, got %s", expected, body)
+		}
+
+		writeJSON(w, http.StatusOK, `{"data":{"id":"e1","path":"/static","rule_ids":["r1"],"description":"static assets"}}`)
+	})
+
+	exclusion, err := client.UpdateWAFExclusion(context.Background(), "example.com", "e1", WAFExclusionUpdateOptions{Description: "static assets"})
+	if err != nil {
+		t.Fatalf("Error updating exclusion: %s", err)
+	}
+
+	if !cmp.Equal(exclusion.RuleIDs, []string{"r1"}) {
+		t.Errorf("expected the rules to be kept, got %v", exclusion.RuleIDs)
+	}
+}
+
+func TestUpdateWAFExclusion_clearRules(t *testing.T) {
+	var bodies []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		writeJSON(w, http.StatusOK, `{"data":{"id":"e1","path":"/static","package_ids":["p1"],"rule_ids":[]}}`)
+	})
+
+	exclusion := WAFExclusion{ID: "e1", Path: "/static", PackageIDs: []string{"p1"}, RuleIDs: []string{"r1"}}
+
+	updateOpts := exclusion.GetUpdateOptions()
+	*updateOpts.RuleIDs = []string{}
+	if _, err := client.UpdateWAFExclusion(context.Background(), "example.com", exclusion.ID, updateOpts); err != nil {
+		t.Fatalf("Error updating exclusion: %s", err)
+	}
+
+	noRules := []string{}
+	if _, err := client.UpdateWAFExclusion(context.Background(), "example.com", exclusion.ID, WAFExclusionUpdateOptions{RuleIDs: &noRules}); err != nil {
+		t.Fatalf("Error updating exclusion: %s", err)
+	}
+
+	expected := []string{
+		`{"path":"/static","package_ids":["p1"],"rule_ids":[]}`,
+		`{"rule_ids":[]}`,
+	}
+	if !cmp.Equal(bodies, expected) {
+		t.Error(cmp.Diff(bodies, expected))
+	}
+}
@@ -0,0 +1,38 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WaitForServerStatus waits for the Server to reach the desired state
+// before returning. It will timeout with an error after timeoutSeconds,
+// or fail early when the server errors.
+func (c *Client) WaitForServerStatus(ctx context.Context, region, serverID string, status ServerStatus, timeoutSeconds int) (*Server, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(c.millisecondsPerPoll * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			server, err := c.GetServer(ctx, region, serverID)
+			if err != nil {
+				return server, err
+			}
+
+			if server.Status == status {
+				return server, nil
+			}
+
+			if server.Status == ServerStatusError {
+				return server, fmt.Errorf("Server %s errored waiting for status %s", serverID, status)
+			}
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Error waiting for Server %s status %s: %s", serverID, status, ctx.Err())
+		}
+	}
+}
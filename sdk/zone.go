@@ -0,0 +1,86 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"strconv"
+)
+
+// ImportOptions control how ImportZone merges a zone file into a Domain
+type ImportOptions struct {
+	// ReplaceExisting deletes the records of the Domain that are not in the zone file
+	ReplaceExisting bool
+	// Cloud proxies the imported A, AAAA and CNAME records through the CDN
+	Cloud bool
+}
+
+// ExportZone exports the DNS records of a Domain as an RFC 1035 zone file.
+// Use the zonefile package to parse it into DomainRecords.
+func (c *Client) ExportZone(ctx context.Context, domain string) (io.Reader, error) {
+	e, err := c.DomainRecords.endpointWithParams(domain)
+	if err != nil {
+		return nil, err
+	}
+	e = fmt.Sprintf("%s/export", e)
+
+	r, err := c.R(ctx).Get(e)
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	// The zone file is plain text, only error responses are JSON. Error responses
+	// without errors, e.g. a gateway page, must not be mistaken for the zone file.
+	if r.IsError() {
+		if _, err := coupleAPIErrors(r, nil); err != nil {
+			return nil, err
+		}
+		return nil, Error{Code: r.StatusCode(), Message: r.Status()}
+	}
+
+	return bytes.NewReader(r.Body()), nil
+}
+
+// ImportZone imports the records of an RFC 1035 zone file read from zone into a Domain
+func (c *Client) ImportZone(ctx context.Context, domain string, zone io.Reader, opts ImportOptions) error {
+	e, err := c.DomainRecords.endpointWithParams(domain)
+	if err != nil {
+		return err
+	}
+	e = fmt.Sprintf("%s/import", e)
+
+	// The multipart body is built up front rather than streamed from zone so
+	// that retried requests, e.g. after a 429, upload the same zone file
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	part, err := w.CreateFormFile("f_zone_file", domain+".zone")
+	if err != nil {
+		return NewError(err)
+	}
+	if _, err := io.Copy(part, zone); err != nil {
+		return NewError(err)
+	}
+
+	fields := map[string]string{
+		"replace_existing": strconv.FormatBool(opts.ReplaceExisting),
+		"cloud":            strconv.FormatBool(opts.Cloud),
+	}
+	for _, name := range []string{"replace_existing", "cloud"} {
+		if err := w.WriteField(name, fields[name]); err != nil {
+			return NewError(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return NewError(err)
+	}
+
+	req := c.R(ctx).
+		SetHeader("Content-Type", w.FormDataContentType()).
+		SetBody(body.Bytes())
+
+	_, err = coupleAPIErrors(req.Post(e))
+	return err
+}
@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExportZone(t *testing.T) {
+	zone := "$ORIGIN example.com.\nwww\t300\tIN\tA\t192.0.2.1\n"
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cdn/4.0/domains/example.com/dns-records/export":
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte(zone))
+		default:
+			writeJSON(w, http.StatusNotFound, `{"message":"Domain not found.","errors":{"domain":["Domain not found."]}}`)
+		}
+	})
+
+	r, err := client.ExportZone(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("Error exporting zone: %s", err)
+	}
+
+	if b, _ := ioutil.ReadAll(r); string(b) != zone {
+		t.Errorf("expected zone file %q, got %q", zone, b)
+	}
+
+	if _, err := client.ExportZone(context.Background(), "example.org"); err == nil {
+		t.Error("expected error exporting the zone of a missing domain")
+	}
+}
+
+func TestExportZone_errorWithoutErrors(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusInternalServerError, `{"message":"Internal server error."}`)
+	})
+
+	r, err := client.ExportZone(context.Background(), "example.com")
+	if err == nil {
+		b, _ := ioutil.ReadAll(r)
+		t.Fatalf("expected error exporting zone, got zone file %q", b)
+	}
+
+	if apiErr, ok := err.(Error); !ok || apiErr.Code != http.StatusInternalServerError {
+		t.Errorf("unexpected error %#v", err)
+	}
+}
+
+func TestImportZone_retry(t *testing.T) {
+	var uploads []string
+
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/cdn/4.0/domains/example.com/dns-records/import" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		file, _, err := r.FormFile("f_zone_file")
+		if err != nil {
+			t.Fatalf("Error reading the zone file: %s", err)
+		}
+		zone, _ := ioutil.ReadAll(file)
+		uploads = append(uploads, fmt.Sprintf("%s replace_existing=%s cloud=%s", zone, r.FormValue("replace_existing"), r.FormValue("cloud")))
+
+		if len(uploads) == 1 {
+			w.Header().Set("Retry-After", "0")
+			writeJSON(w, http.StatusTooManyRequests, `{"message":"too many requests"}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"message":"imported"}`)
+	})
+	client.SetRetryWaitTime(time.Millisecond)
+
+	zone := "www 300 IN A 192.0.2.1\n"
+	if err := client.ImportZone(context.Background(), "example.com", strings.NewReader(zone), ImportOptions{ReplaceExisting: true}); err != nil {
+		t.Fatalf("Error importing zone: %s", err)
+	}
+
+	expected := []string{
+		zone + " replace_existing=true cloud=false",
+		zone + " replace_existing=true cloud=false",
+	}
+	if !cmp.Equal(uploads, expected) {
+		t.Error(cmp.Diff(uploads, expected))
+	}
+}
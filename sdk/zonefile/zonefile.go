@@ -0,0 +1,566 @@
+// Package zonefile converts between RFC 1035 zone files and the DomainRecords
+// of the sdk package, without calling the Arvancloud API.
+package zonefile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/S4eedb/arvancloud-go/sdk"
+)
+
+// DefaultTTL is the TTL of records of zone files without a $TTL directive or explicit TTLs
+const DefaultTTL = 3600
+
+// txtChunkSize is the longest character-string allowed in a TXT record
+const txtChunkSize = 255
+
+// Error is a zone file syntax or semantic error at Line
+type Error struct {
+	Line    int
+	Message string
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("zonefile: line %d: %s", e.Line, e.Message)
+}
+
+// token is a word of a zone file line; quoted tokens may contain whitespace
+type token struct {
+	text   string
+	quoted bool
+}
+
+// Parse reads the zone file of zone from r and returns its records.
+//
+// Owner names are made relative to zone, "@" for the apex, and host names are made
+// absolute without a trailing dot. A and AAAA records of the same owner are merged
+// into a single DomainRecord with one AddressRecordValue per address, as the API
+// expects, so they must share a TTL. SOA records are skipped since the zone's SOA is managed by Arvancloud.
+// $INCLUDE and record types the sdk package does not support are errors.
+func Parse(r io.Reader, zone string) ([]sdk.DomainRecord, error) {
+	p := &parser{
+		zone:   canonical(zone),
+		origin: canonical(zone),
+		ttl:    DefaultTTL,
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var (
+		line      int
+		startLine int
+		tokens    []token
+		depth     int
+		indented  bool
+	)
+
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+
+		if depth == 0 {
+			startLine = line
+			indented = len(text) > 0 && (text[0] == ' ' || text[0] == '\t')
+		}
+
+		lineTokens, lineDepth, err := tokenize(text)
+		if err != nil {
+			return nil, Error{Line: line, Message: err.Error()}
+		}
+		tokens = append(tokens, lineTokens...)
+		depth += lineDepth
+
+		if depth < 0 {
+			return nil, Error{Line: line, Message: "unbalanced parentheses"}
+		}
+
+		if depth > 0 {
+			continue
+		}
+
+		if len(tokens) > 0 {
+			if err := p.entry(tokens, indented); err != nil {
+				return nil, Error{Line: startLine, Message: err.Error()}
+			}
+		}
+		tokens = nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if depth != 0 {
+		return nil, Error{Line: startLine, Message: "unterminated parentheses"}
+	}
+
+	return p.records, nil
+}
+
+// tokenize splits a line into tokens, dropping comments. It returns the change in
+// parenthesis depth, as parentheses allow a record to span several lines.
+func tokenize(line string) ([]token, int, error) {
+	var (
+		tokens []token
+		depth  int
+		word   strings.Builder
+		inWord bool
+	)
+
+	flush := func() {
+		if inWord {
+			tokens = append(tokens, token{text: word.String()})
+			word.Reset()
+			inWord = false
+		}
+	}
+
+	for i := 0; i < len(line); i++ {
+		ch := line[i]
+
+		switch {
+		case ch == ';':
+			flush()
+			return tokens, depth, nil
+		case ch == '(':
+			flush()
+			depth++
+		case ch == ')':
+			flush()
+			depth--
+		case ch == ' ' || ch == '\t' || ch == '\r':
+			flush()
+		case ch == '"':
+			flush()
+			text, end, err := quoted(line, i+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			tokens = append(tokens, token{text: text, quoted: true})
+			i = end
+		case ch == '\\' && i+1 < len(line):
+			word.WriteByte(ch)
+			word.WriteByte(line[i+1])
+			inWord = true
+			i++
+		default:
+			word.WriteByte(ch)
+			inWord = true
+		}
+	}
+	flush()
+
+	return tokens, depth, nil
+}
+
+// quoted reads a quoted character-string starting after its opening quote at start,
+// resolving \X and \DDD escapes. It returns the index of the closing quote.
+func quoted(line string, start int) (string, int, error) {
+	var b strings.Builder
+
+	for i := start; i < len(line); i++ {
+		switch ch := line[i]; ch {
+		case '"':
+			return b.String(), i, nil
+		case '\\':
+			if i+3 < len(line) && isDigits(line[i+1:i+4]) {
+				n, _ := strconv.Atoi(line[i+1 : i+4])
+				if n > 255 {
+					return "", 0, fmt.Errorf("invalid escape \\%s", line[i+1:i+4])
+				}
+				b.WriteByte(byte(n))
+				i += 3
+			} else if i+1 < len(line) {
+				b.WriteByte(line[i+1])
+				i++
+			}
+		default:
+			b.WriteByte(ch)
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated quoted string")
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+type parser struct {
+	zone    string
+	origin  string
+	ttl     int
+	owner   string
+	records []sdk.DomainRecord
+}
+
+// entry handles a directive or resource record made of tokens. indented entries
+// inherit the owner of the previous record.
+func (p *parser) entry(tokens []token, indented bool) error {
+	if !indented && strings.HasPrefix(tokens[0].text, "$") && !tokens[0].quoted {
+		return p.directive(tokens)
+	}
+
+	if !indented {
+		p.owner = p.absolute(tokens[0].text)
+		tokens = tokens[1:]
+	}
+
+	if p.owner == "" {
+		return fmt.Errorf("record without owner name")
+	}
+
+	ttl := p.ttl
+	for len(tokens) > 0 {
+		word := strings.ToUpper(tokens[0].text)
+
+		if word == "IN" {
+			tokens = tokens[1:]
+			continue
+		}
+
+		if word == "CH" || word == "HS" || word == "CS" {
+			return fmt.Errorf("unsupported class %s", word)
+		}
+
+		if seconds, err := parseTTL(tokens[0].text); err == nil {
+			ttl = seconds
+			tokens = tokens[1:]
+			continue
+		}
+
+		break
+	}
+
+	if len(tokens) == 0 {
+		return fmt.Errorf("record without type")
+	}
+
+	name, err := p.relative(p.owner)
+	if err != nil {
+		return err
+	}
+
+	return p.record(name, strings.ToUpper(tokens[0].text), ttl, tokens[1:])
+}
+
+func (p *parser) directive(tokens []token) error {
+	switch strings.ToUpper(tokens[0].text) {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return fmt.Errorf("$ORIGIN takes one domain name")
+		}
+		p.origin = p.absolute(tokens[1].text)
+	case "$TTL":
+		if len(tokens) != 2 {
+			return fmt.Errorf("$TTL takes one TTL")
+		}
+		ttl, err := parseTTL(tokens[1].text)
+		if err != nil {
+			return err
+		}
+		p.ttl = ttl
+	default:
+		return fmt.Errorf("unsupported directive %s", tokens[0].text)
+	}
+
+	return nil
+}
+
+func (p *parser) record(name, recordType string, ttl int, rdata []token) error {
+	record := sdk.DomainRecord{Name: name, TTL: ttl}
+
+	need := func(n int) error {
+		if len(rdata) != n {
+			return fmt.Errorf("%s record takes %d fields, got %d", recordType, n, len(rdata))
+		}
+		return nil
+	}
+
+	switch recordType {
+	case "SOA":
+		return nil
+	case "A", "AAAA":
+		if err := need(1); err != nil {
+			return err
+		}
+
+		ip := net.ParseIP(rdata[0].text)
+		if ip == nil || (recordType == "A") != (ip.To4() != nil) {
+			return fmt.Errorf("invalid %s address %q", recordType, rdata[0].text)
+		}
+
+		return p.addAddress(name, sdk.DomainRecordType(strings.ToLower(recordType)), ttl, ip.String())
+	case "CNAME", "ANAME", "NS":
+		if err := need(1); err != nil {
+			return err
+		}
+		record.Type = sdk.DomainRecordType(strings.ToLower(recordType))
+		record.Value = sdk.HostRecordValue{Host: p.host(rdata[0].text)}
+	case "PTR":
+		if err := need(1); err != nil {
+			return err
+		}
+		record.Type = sdk.RecordTypePTR
+		record.Value = sdk.PTRRecordValue{Domain: p.host(rdata[0].text)}
+	case "MX":
+		if err := need(2); err != nil {
+			return err
+		}
+		priority, err := strconv.Atoi(rdata[0].text)
+		if err != nil {
+			return fmt.Errorf("invalid MX preference %q", rdata[0].text)
+		}
+		record.Type = sdk.RecordTypeMX
+		record.Value = sdk.MXRecordValue{Host: p.host(rdata[1].text), Priority: priority}
+	case "TXT":
+		if len(rdata) == 0 {
+			return fmt.Errorf("TXT record without text")
+		}
+		var text strings.Builder
+		for _, t := range rdata {
+			text.WriteString(t.text)
+		}
+		record.Type = sdk.RecordTypeTXT
+		record.Value = sdk.TXTRecordValue{Text: text.String()}
+	case "SRV":
+		if err := need(4); err != nil {
+			return err
+		}
+		numbers := make([]int, 3)
+		for i := range numbers {
+			n, err := strconv.Atoi(rdata[i].text)
+			if err != nil {
+				return fmt.Errorf("invalid SRV field %q", rdata[i].text)
+			}
+			numbers[i] = n
+		}
+		record.Type = sdk.RecordTypeSRV
+		record.Value = sdk.SRVRecordValue{
+			Priority: numbers[0],
+			Weight:   numbers[1],
+			Port:     numbers[2],
+			Target:   p.host(rdata[3].text),
+		}
+	case "CAA":
+		if err := need(3); err != nil {
+			return err
+		}
+		flag, err := strconv.Atoi(rdata[0].text)
+		if err != nil || flag < 0 || flag > 255 {
+			return fmt.Errorf("invalid CAA flag %q", rdata[0].text)
+		}
+		record.Type = sdk.RecordTypeCAA
+		record.Value = sdk.CAARecordValue{Flag: flag, Tag: rdata[1].text, Value: rdata[2].text}
+	default:
+		return fmt.Errorf("unsupported record type %s", recordType)
+	}
+
+	p.records = append(p.records, record)
+	return nil
+}
+
+// addAddress appends ip to the A or AAAA record of name, creating it if needed
+func (p *parser) addAddress(name string, recordType sdk.DomainRecordType, ttl int, ip string) error {
+	for i, r := range p.records {
+		if r.Name == name && r.Type == recordType {
+			if r.TTL != ttl {
+				return fmt.Errorf("%s record of %s has TTL %d, previous ones have %d", strings.ToUpper(string(recordType)), name, ttl, r.TTL)
+			}
+			p.records[i].Value = append(r.Value.([]sdk.AddressRecordValue), sdk.AddressRecordValue{IP: ip})
+			return nil
+		}
+	}
+
+	p.records = append(p.records, sdk.DomainRecord{
+		Name:  name,
+		Type:  recordType,
+		TTL:   ttl,
+		Value: []sdk.AddressRecordValue{{IP: ip}},
+	})
+
+	return nil
+}
+
+// absolute resolves name against the current origin, returning it with a trailing dot
+func (p *parser) absolute(name string) string {
+	switch {
+	case name == "@":
+		return p.origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name) + "." + p.origin
+	}
+}
+
+// relative returns the absolute name relative to the zone, "@" for the apex
+func (p *parser) relative(name string) (string, error) {
+	if name == p.zone {
+		return "@", nil
+	}
+
+	if !strings.HasSuffix(name, "."+p.zone) {
+		return "", fmt.Errorf("owner %s is outside of zone %s", name, p.zone)
+	}
+
+	return strings.TrimSuffix(name, "."+p.zone), nil
+}
+
+// host resolves a host name in record data, returning it without a trailing dot
+func (p *parser) host(name string) string {
+	return strings.TrimSuffix(p.absolute(name), ".")
+}
+
+// canonical lower cases a domain name and adds a trailing dot
+func canonical(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, ".")) + "."
+}
+
+// parseTTL parses a TTL in seconds or with BIND units, such as "1h30m"
+func parseTTL(s string) (int, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty TTL")
+	}
+
+	if n, err := strconv.Atoi(s); err == nil && n >= 0 {
+		return n, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+
+	total, current, digits := 0, 0, false
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+
+		if ch >= '0' && ch <= '9' {
+			current = current*10 + int(ch-'0')
+			digits = true
+			continue
+		}
+
+		unit, ok := units[ch|0x20]
+		if !ok || !digits {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		total += current * unit
+		current, digits = 0, false
+	}
+
+	if digits {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+
+	return total, nil
+}
+
+// Write writes records of zone to w as an RFC 1035 zone file, the inverse of Parse.
+// Records with a zero TTL are written with DefaultTTL.
+func Write(w io.Writer, zone string, records []sdk.DomainRecord) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "$ORIGIN %s\n", canonical(zone))
+
+	for _, r := range records {
+		lines, err := FormatRecord(r)
+		if err != nil {
+			return err
+		}
+
+		for _, line := range lines {
+			fmt.Fprintln(bw, line)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// FormatRecord formats r as zone file lines, one per value of A and AAAA records.
+// Records with a zero TTL are formatted with DefaultTTL.
+func FormatRecord(r sdk.DomainRecord) ([]string, error) {
+	rdata, err := recordLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	name := r.Name
+	if name == "" {
+		name = "@"
+	}
+
+	ttl := r.TTL
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+
+	lines := make([]string, 0, len(rdata))
+	for _, data := range rdata {
+		lines = append(lines, fmt.Sprintf("%s\t%d\tIN\t%s\t%s", name, ttl, strings.ToUpper(string(r.Type)), data))
+	}
+
+	return lines, nil
+}
+
+// recordLines returns the RDATA of each zone file line needed for r
+func recordLines(r sdk.DomainRecord) ([]string, error) {
+	switch v := r.Value.(type) {
+	case []sdk.AddressRecordValue:
+		lines := make([]string, 0, len(v))
+		for _, address := range v {
+			lines = append(lines, address.IP)
+		}
+		return lines, nil
+	case sdk.HostRecordValue:
+		return []string{fqdn(v.Host)}, nil
+	case sdk.PTRRecordValue:
+		return []string{fqdn(v.Domain)}, nil
+	case sdk.MXRecordValue:
+		return []string{fmt.Sprintf("%d %s", v.Priority, fqdn(v.Host))}, nil
+	case sdk.TXTRecordValue:
+		return []string{quoteTXT(v.Text)}, nil
+	case sdk.SRVRecordValue:
+		return []string{fmt.Sprintf("%d %d %d %s", v.Priority, v.Weight, v.Port, fqdn(v.Target))}, nil
+	case sdk.CAARecordValue:
+		return []string{fmt.Sprintf("%d %s %s", v.Flag, v.Tag, quote(v.Value))}, nil
+	}
+
+	return nil, fmt.Errorf("zonefile: unsupported value %T for %s record %s", r.Value, r.Type, r.Name)
+}
+
+func fqdn(host string) string {
+	return strings.TrimSuffix(host, ".") + "."
+}
+
+var quoteEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func quote(s string) string {
+	return `"` + quoteEscaper.Replace(s) + `"`
+}
+
+// quoteTXT quotes text, split into character-strings of at most txtChunkSize bytes
+func quoteTXT(text string) string {
+	if text == "" {
+		return `""`
+	}
+
+	var chunks []string
+	for len(text) > txtChunkSize {
+		chunks = append(chunks, quote(text[:txtChunkSize]))
+		text = text[txtChunkSize:]
+	}
+	chunks = append(chunks, quote(text))
+
+	return strings.Join(chunks, " ")
+}
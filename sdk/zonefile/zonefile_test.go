@@ -0,0 +1,152 @@
+package zonefile
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/S4eedb/arvancloud-go/sdk"
+	"github.com/google/go-cmp/cmp"
+)
+
+const testZone = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. admin.example.com. (
+		2021120101 ; serial
+		7200 3600 1209600 300 )
+@		IN	A	192.0.2.1
+		IN	A	192.0.2.2
+www	300	IN	CNAME	@
+mail		MX	10 mx1.example.net.
+@		TXT	"v=spf1 include:_spf.example.net" " ~all"
+_sip._tcp	SRV	10 60 5060 sip ; relative target
+@		CAA	0 issue "letsencrypt.org"
+$ORIGIN sub.example.com.
+v6		AAAA	2001:db8::1
+`
+
+func TestParse(t *testing.T) {
+	records, err := Parse(strings.NewReader(testZone), "example.com")
+	if err != nil {
+		t.Fatalf("Error parsing zone: %s", err)
+	}
+
+	expected := []sdk.DomainRecord{
+		{Name: "@", Type: sdk.RecordTypeA, TTL: 3600, Value: []sdk.AddressRecordValue{{IP: "192.0.2.1"}, {IP: "192.0.2.2"}}},
+		{Name: "www", Type: sdk.RecordTypeCNAME, TTL: 300, Value: sdk.HostRecordValue{Host: "example.com"}},
+		{Name: "mail", Type: sdk.RecordTypeMX, TTL: 3600, Value: sdk.MXRecordValue{Host: "mx1.example.net", Priority: 10}},
+		{Name: "@", Type: sdk.RecordTypeTXT, TTL: 3600, Value: sdk.TXTRecordValue{Text: "v=spf1 include:_spf.example.net ~all"}},
+		{Name: "_sip._tcp", Type: sdk.RecordTypeSRV, TTL: 3600, Value: sdk.SRVRecordValue{Priority: 10, Weight: 60, Port: 5060, Target: "sip.example.com"}},
+		{Name: "@", Type: sdk.RecordTypeCAA, TTL: 3600, Value: sdk.CAARecordValue{Tag: "issue", Value: "letsencrypt.org"}},
+		{Name: "v6.sub", Type: sdk.RecordTypeAAAA, TTL: 3600, Value: []sdk.AddressRecordValue{{IP: "2001:db8::1"}}},
+	}
+
+	if !cmp.Equal(records, expected) {
+		t.Error(cmp.Diff(records, expected))
+	}
+}
+
+func TestParse_errors(t *testing.T) {
+	for _, tc := range []struct {
+		zone string
+		line int
+	}{
+		{"www A 192.0.2.1\nfoo.other.com. A 192.0.2.1\n", 2},
+		{"www A not-an-ip\n", 1},
+		{"www AAAA 192.0.2.1\n", 1},
+		{"www HINFO cpu os\n", 1},
+		{"www TXT \"unterminated\n", 1},
+		{"@ SOA ns1 admin (\n1 2 3 4 5\n", 1},
+		{"www 300 A 192.0.2.1\nwww 600 A 192.0.2.2\n", 2},
+		{"@ CAA 256 issue \"letsencrypt.org\"\n", 1},
+	} {
+		_, err := Parse(strings.NewReader(tc.zone), "example.com")
+
+		zoneErr, ok := err.(Error)
+		if !ok {
+			t.Errorf("expected zonefile.Error parsing %q, got %v", tc.zone, err)
+			continue
+		}
+
+		if zoneErr.Line != tc.line {
+			t.Errorf("expected error on line %d parsing %q, got %s", tc.line, tc.zone, zoneErr)
+		}
+	}
+}
+
+func TestWrite_roundTrip(t *testing.T) {
+	records, err := Parse(strings.NewReader(testZone), "example.com.")
+	if err != nil {
+		t.Fatalf("Error parsing zone: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "example.com", records); err != nil {
+		t.Fatalf("Error writing zone: %s", err)
+	}
+
+	if !strings.Contains(buf.String(), "www\t300\tIN\tCNAME\texample.com.\n") {
+		t.Errorf("unexpected zone file:\n%s", buf.String())
+	}
+
+	reparsed, err := Parse(&buf, "example.com")
+	if err != nil {
+		t.Fatalf("Error parsing written zone: %s", err)
+	}
+
+	if !cmp.Equal(reparsed, records) {
+		t.Error(cmp.Diff(reparsed, records))
+	}
+}
+
+func TestWrite_roundTripRecordTypes(t *testing.T) {
+	records := []sdk.DomainRecord{
+		{Name: "@", Type: sdk.RecordTypeA, TTL: 300, Value: []sdk.AddressRecordValue{{IP: "192.0.2.1"}, {IP: "192.0.2.2"}}},
+		{Name: "v6", Type: sdk.RecordTypeAAAA, TTL: 600, Value: []sdk.AddressRecordValue{{IP: "2001:db8::1"}}},
+		{Name: "www", Type: sdk.RecordTypeCNAME, TTL: 3600, Value: sdk.HostRecordValue{Host: "example.net"}},
+		{Name: "apex", Type: sdk.RecordTypeANAME, TTL: 3600, Value: sdk.HostRecordValue{Host: "lb.example.net"}},
+		{Name: "sub", Type: sdk.RecordTypeNS, TTL: 86400, Value: sdk.HostRecordValue{Host: "ns1.example.net"}},
+		{Name: "1.2", Type: sdk.RecordTypePTR, TTL: 3600, Value: sdk.PTRRecordValue{Domain: "host.example.com"}},
+		{Name: "@", Type: sdk.RecordTypeMX, TTL: 3600, Value: sdk.MXRecordValue{Host: "mx1.example.net", Priority: 10}},
+		{Name: "@", Type: sdk.RecordTypeTXT, TTL: 3600, Value: sdk.TXTRecordValue{Text: "v=spf1 -all"}},
+		{Name: "_sip._tcp", Type: sdk.RecordTypeSRV, TTL: 3600, Value: sdk.SRVRecordValue{Priority: 10, Weight: 60, Port: 5060, Target: "sip.example.com"}},
+		{Name: "@", Type: sdk.RecordTypeCAA, TTL: 3600, Value: sdk.CAARecordValue{Flag: 128, Tag: "issue", Value: "letsencrypt.org"}},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "example.com", records); err != nil {
+		t.Fatalf("Error writing zone: %s", err)
+	}
+
+	reparsed, err := Parse(&buf, "example.com")
+	if err != nil {
+		t.Fatalf("Error parsing written zone: %s", err)
+	}
+
+	if !cmp.Equal(reparsed, records) {
+		t.Error(cmp.Diff(reparsed, records))
+	}
+}
+
+func TestWrite_longTXT(t *testing.T) {
+	text := strings.Repeat("a", 300) + `"quoted"`
+	records := []sdk.DomainRecord{{Name: "@", Type: sdk.RecordTypeTXT, Value: sdk.TXTRecordValue{Text: text}}}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "example.com", records); err != nil {
+		t.Fatalf("Error writing zone: %s", err)
+	}
+
+	reparsed, err := Parse(&buf, "example.com")
+	if err != nil {
+		t.Fatalf("Error parsing written zone: %s", err)
+	}
+
+	if got := reparsed[0].Value.(sdk.TXTRecordValue).Text; got != text {
+		t.Errorf("expected TXT text to round trip, got %q", got)
+	}
+
+	if reparsed[0].TTL != DefaultTTL {
+		t.Errorf("expected default TTL, got %d", reparsed[0].TTL)
+	}
+}